@@ -0,0 +1,105 @@
+package skiplist
+
+// Monoid is an associative way of combining values, such as a sum, min or max. Combine must be
+// associative, and Identity must leave any value unchanged when combined with it.
+type Monoid[V any] struct {
+	Identity V
+	Combine  func(V, V) V
+}
+
+// SetMonoid attaches a monoid to the skip list, after which every forward link stores the
+// aggregate of the values in the span it skips over, so that Aggregate runs in O(logN). Keeping
+// the aggregates current makes each insert, update and delete do O(logN) combines. A monoid with a
+// nil Combine function detaches the current one. Time complexity: O(N) to build the aggregates.
+func (sl *SkipList[K, V]) SetMonoid(m Monoid[V]) {
+	sl.rw.Lock()
+	defer sl.rw.Unlock()
+
+	if m.Combine == nil {
+		sl.monoid = nil
+		for x := sl.header; x != nil; x = x.forward[0] {
+			x.agg = nil
+		}
+		return
+	}
+	sl.monoid = &m
+	sl.rebuildAggregates()
+}
+
+// Aggregate returns the combination, under the list's monoid, of the values of every element with
+// key greater than or equal to start (inclusive) and less than end (exclusive), or the monoid's
+// identity if there are none. Panics if no monoid has been attached with SetMonoid.
+// Time complexity: O(logN), where N is the number of elements in the skip list.
+func (sl *SkipList[K, V]) Aggregate(start, end K) V {
+	sl.rw.RLock()
+	defer sl.rw.RUnlock()
+
+	if sl.monoid == nil {
+		panic("skiplist: Aggregate called without a monoid")
+	}
+
+	_, x := sl.searchNode(start)
+	x = x.forward[0]
+	acc := sl.monoid.Identity
+	for x != nil && sl.less(x.key, end) {
+		// take the highest link out of x that doesn't skip past end; the link on level 0 only
+		// covers x itself, so it can always be taken
+		i := x.level()
+		for i > 0 && (x.forward[i] == nil || !sl.less(x.forward[i].key, end)) {
+			i--
+		}
+		acc = sl.monoid.Combine(acc, x.agg[i])
+		x = x.forward[i]
+	}
+	return acc
+}
+
+// rebuildAggregates recomputes the aggregate of every link, one level at a time from the bottom.
+func (sl *SkipList[K, V]) rebuildAggregates() {
+	for x := sl.header; x != nil; x = x.forward[0] {
+		x.agg = make([]V, len(x.forward))
+		x.agg[0] = sl.nodeValue(x)
+	}
+	for i := 1; i < len(sl.header.forward); i++ {
+		for x := sl.header; x != nil; x = x.forward[i] {
+			sl.updateLink(x, i)
+		}
+	}
+}
+
+// fixAggregates recomputes the aggregates of the links that span x, and of the links out of x
+// itself, after x was inserted or its value changed, or after a node was removed if x is nil.
+// update must be the result of searching for the key of the inserted, updated or removed node.
+func (sl *SkipList[K, V]) fixAggregates(update []*slNode[K, V], x *slNode[K, V]) {
+	if x != nil {
+		if x.agg == nil {
+			x.agg = make([]V, len(x.forward))
+		}
+		x.agg[0] = x.val
+	}
+	for i := 1; i <= sl.level; i++ {
+		if x != nil && i <= x.level() {
+			sl.updateLink(x, i)
+		}
+		sl.updateLink(update[i], i)
+	}
+}
+
+// updateLink recomputes the aggregate of the link out of x on level i > 0 by combining the links
+// on level i-1 that it spans.
+func (sl *SkipList[K, V]) updateLink(x *slNode[K, V], i int) {
+	acc := x.agg[i-1]
+	for y := x.forward[i-1]; y != x.forward[i]; y = y.forward[i-1] {
+		acc = sl.monoid.Combine(acc, y.agg[i-1])
+	}
+	x.agg[i] = acc
+}
+
+// nodeValue returns the value of x for the purposes of aggregation, which for the header is the
+// monoid's identity.
+func (sl *SkipList[K, V]) nodeValue(x *slNode[K, V]) V {
+	if x.isHeader {
+		return sl.monoid.Identity
+	}
+	return x.val
+}
@@ -0,0 +1,89 @@
+package skiplist
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSkipList_Aggregate(t *testing.T) {
+	sl := NewSkipList[int, int]()
+	for i := 0; i < 100; i++ {
+		sl.Set(i, i)
+	}
+	sl.SetMonoid(Monoid[int]{Identity: 0, Combine: func(a, b int) int { return a + b }})
+
+	want := make(map[int]int)
+	for i := 0; i < 100; i++ {
+		want[i] = i
+	}
+
+	sum := func(start, end int) int {
+		total := 0
+		for k, v := range want {
+			if k >= start && k < end {
+				total += v
+			}
+		}
+		return total
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 2000; n++ {
+		key := r.Intn(200) - 50
+		switch r.Intn(4) {
+		case 0, 1:
+			sl.Set(key, n)
+			want[key] = n
+		case 2:
+			sl.Delete(key)
+			delete(want, key)
+		case 3:
+			it := sl.Iterator()
+			if it.Seek(key) {
+				if n%2 == 0 {
+					delete(want, it.Key())
+					it.Remove()
+				} else {
+					want[it.Key()] = -n
+					it.SetValue(-n)
+				}
+			}
+		}
+
+		start := r.Intn(200) - 50
+		end := start + r.Intn(100)
+		if got := sl.Aggregate(start, end); got != sum(start, end) {
+			t.Fatalf("aggregate [%d, %d): want %d, got %d", start, end, sum(start, end), got)
+		}
+	}
+
+	if got := sl.Aggregate(-1000, 1000); got != sum(-1000, 1000) {
+		t.Errorf("aggregate over everything: want %d, got %d", sum(-1000, 1000), got)
+	}
+
+	sl.Clear()
+	if got := sl.Aggregate(-1000, 1000); got != 0 {
+		t.Errorf("aggregate after clear: want 0, got %d", got)
+	}
+	sl.Set(3, 3)
+	if got := sl.Aggregate(0, 10); got != 3 {
+		t.Errorf("aggregate after clear and set: want 3, got %d", got)
+	}
+}
+
+func TestSkipList_AggregateMax(t *testing.T) {
+	items := []Pair[string, int]{{"a", 4}, {"b", 9}, {"c", 1}, {"d", 7}, {"e", 3}}
+	sl := NewSkipList(items...)
+	sl.SetMonoid(Monoid[int]{Identity: -1 << 63, Combine: func(a, b int) int { return max(a, b) }})
+
+	if got := sl.Aggregate("c", "z"); got != 7 {
+		t.Errorf("max: want 7, got %d", got)
+	}
+	if got := sl.Aggregate("a", "c"); got != 9 {
+		t.Errorf("max: want 9, got %d", got)
+	}
+	sl.Delete("b")
+	if got := sl.Aggregate("a", "c"); got != 4 {
+		t.Errorf("max after delete: want 4, got %d", got)
+	}
+}
@@ -0,0 +1,76 @@
+package skiplist
+
+import "slices"
+
+// Batch is a list of Set and Delete operations that Apply commits to a skip list atomically. The
+// zero value is an empty batch ready to use.
+type Batch[K, V any] struct {
+	ops []batchOp[K, V]
+}
+
+type batchOp[K, V any] struct {
+	key    K
+	val    V
+	delete bool
+}
+
+// Set adds setting key to val to the batch.
+func (b *Batch[K, V]) Set(key K, val V) {
+	b.ops = append(b.ops, batchOp[K, V]{key: key, val: val})
+}
+
+// Delete adds deleting key to the batch.
+func (b *Batch[K, V]) Delete(key K) {
+	b.ops = append(b.ops, batchOp[K, V]{key: key, delete: true})
+}
+
+// Len returns the number of operations in the batch.
+func (b *Batch[K, V]) Len() int {
+	return len(b.ops)
+}
+
+// Reset removes all operations from the batch so it can be reused.
+func (b *Batch[K, V]) Reset() {
+	clear(b.ops)
+	b.ops = b.ops[:0]
+}
+
+// Apply commits every operation in the batch under a single write lock, so readers see either
+// none or all of them. Operations on the same key take effect in the order they were added, and
+// watchers receive the resulting events in key order. The operations are sorted first so that a
+// single search finger moves forward through the list, rather than each key being searched for
+// from the header. The batch is left unchanged.
+// Time complexity: O(MlogM + MlogN) in the worst case, where M is the number of operations.
+func (sl *SkipList[K, V]) Apply(b *Batch[K, V]) {
+	ops := slices.Clone(b.ops)
+	slices.SortStableFunc(ops, func(op1, op2 batchOp[K, V]) int {
+		return sl.compare(op1.key, op2.key)
+	})
+
+	sl.rw.Lock()
+	defer sl.rw.Unlock()
+
+	sl.apply(ops)
+}
+
+// apply performs operations sorted by key but doesn't use locks; this is used by Apply and by
+// transaction commits.
+func (sl *SkipList[K, V]) apply(ops []batchOp[K, V]) {
+	update := make([]*slNode[K, V], sl.maxLevel)
+	for i := range update {
+		update[i] = sl.header
+	}
+	for _, op := range ops {
+		x := sl.searchFrom(update, op.key).forward[0]
+		exists := x != nil && !sl.less(op.key, x.key)
+		switch {
+		case op.delete && exists:
+			sl.removeNode(update, x)
+		case op.delete:
+		case exists:
+			sl.updateNode(update, x, op.val)
+		default:
+			sl.insertNode(update, op.key, op.val)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package skiplist
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSkipList_Apply(t *testing.T) {
+	sl := NewSkipList[int, int]()
+	want := make(map[int]int)
+
+	r := rand.New(rand.NewSource(1))
+	var b Batch[int, int]
+	for round := 0; round < 50; round++ {
+		b.Reset()
+		for n := 0; n < 40; n++ {
+			key := r.Intn(100)
+			if r.Intn(3) == 0 {
+				b.Delete(key)
+				delete(want, key)
+			} else {
+				b.Set(key, n)
+				want[key] = n
+			}
+		}
+		sl.Apply(&b)
+
+		if sl.Len() != len(want) {
+			t.Fatalf("apply: want %d elements, got %d", len(want), sl.Len())
+		}
+		prev := -1
+		for it := sl.Iterator(); it.Next(); {
+			if it.Key() <= prev {
+				t.Fatalf("apply: keys out of order, %d after %d", it.Key(), prev)
+			}
+			if it.Value() != want[it.Key()] {
+				t.Errorf("apply: key %d: want %d, got %d", it.Key(), want[it.Key()], it.Value())
+			}
+			prev = it.Key()
+		}
+	}
+}
+
+func TestSkipList_ApplyEvents(t *testing.T) {
+	sl := NewSkipList(Pair[int, string]{2, "two"})
+	events, cancel := sl.Watch(0, 10)
+	defer cancel()
+
+	var b Batch[int, string]
+	b.Set(5, "five")
+	b.Delete(2)
+	b.Set(1, "one")
+	b.Set(1, "uno")
+	b.Delete(7)
+	sl.Apply(&b)
+
+	want := []Event[int, string]{
+		{Type: EventInsert, Key: 1, Value: "one"},
+		{Type: EventUpdate, Key: 1, Value: "uno", OldValue: "one"},
+		{Type: EventDelete, Key: 2, Value: "two"},
+		{Type: EventInsert, Key: 5, Value: "five"},
+	}
+	for i, w := range want {
+		if got := <-events; got != w {
+			t.Errorf("apply: event %d: want %v, got %v", i, w, got)
+		}
+	}
+	if b.Len() != 5 {
+		t.Errorf("apply: batch modified, want 5 operations, got %d", b.Len())
+	}
+}
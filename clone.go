@@ -0,0 +1,107 @@
+package skiplist
+
+// Clone returns a copy of the skip list with the same comparator, max level and monoid, and with
+// every node on the same levels as in the original, so the copy performs exactly like it. The
+// copy starts with no watchers. Time complexity: O(N), where N is the number of elements.
+func (sl *SkipList[K, V]) Clone() *SkipList[K, V] {
+	sl.rw.RLock()
+	defer sl.rw.RUnlock()
+
+	b := newBuilder[V](sl)
+	for x := sl.header.forward[0]; x != nil; x = x.forward[0] {
+		b.add(x.level(), x.key, x.val)
+	}
+	return b.list(sl.monoid)
+}
+
+// Equal returns true if both skip lists hold the same keys, under sl's comparator, with values
+// that valEq reports as equal. The structure of the lists, i.e. the levels of their nodes, is not
+// compared. other is copied before sl is locked, so that two lists comparing each other at once
+// can't deadlock, and the result reflects each list at a slightly different moment.
+// Time complexity: O(N), where N is the number of elements.
+func (sl *SkipList[K, V]) Equal(other *SkipList[K, V], valEq func(V, V) bool) bool {
+	if sl == other {
+		return true
+	}
+	pairs := other.ToSlice()
+
+	sl.rw.RLock()
+	defer sl.rw.RUnlock()
+
+	if sl.size != len(pairs) {
+		return false
+	}
+	x := sl.header.forward[0]
+	for _, p := range pairs {
+		if sl.compare(x.key, p.key) != 0 || !valEq(x.val, p.val) {
+			return false
+		}
+		x = x.forward[0]
+	}
+	return true
+}
+
+// builder builds a skip list from keys given in increasing order by linking each new node in after
+// the last node on each of its levels, without searching, so the whole list is built in O(N).
+type builder[K, V any] struct {
+	sl   *SkipList[K, V]
+	last []*slNode[K, V] // the last node added on each level, or the header
+}
+
+// newBuilder returns a builder for an empty list, with values of type W, and with the same
+// comparator, max level and header height as sl. It must be called with sl's read lock held.
+func newBuilder[W, K, V any](sl *SkipList[K, V]) *builder[K, W] {
+	b := &builder[K, W]{
+		sl: &SkipList[K, W]{
+			maxLevel: sl.maxLevel,
+			compare:  sl.compare,
+			less:     sl.less,
+			header:   newHeader[K, W](len(sl.header.forward)),
+		},
+		last: make([]*slNode[K, W], len(sl.header.forward)),
+	}
+	for i := range b.last {
+		b.last[i] = b.sl.header
+	}
+	return b
+}
+
+// appender returns a builder that adds nodes to sl itself, which must be empty. It must be called
+// with the write lock held.
+func (sl *SkipList[K, V]) appender() *builder[K, V] {
+	b := &builder[K, V]{sl: sl, last: make([]*slNode[K, V], len(sl.header.forward))}
+	for i := range b.last {
+		b.last[i] = sl.header
+	}
+	return b
+}
+
+// add appends a node on levels 0 through level. Its key must be greater than every key added so
+// far.
+func (b *builder[K, V]) add(level int, key K, val V) {
+	x := newNode[K](level, key, val)
+	x.backward = b.last[0]
+	for i := 0; i <= level; i++ {
+		b.last[i].forward[i] = x
+		b.last[i] = x
+	}
+	b.sl.level = max(b.sl.level, level)
+	b.sl.size++
+	b.sl.max = x
+}
+
+// push appends a node on a random number of levels, as an insertion would. Its key must be
+// greater than every key added so far.
+func (b *builder[K, V]) push(key K, val V) {
+	b.add(b.sl.randomLevel(), key, val)
+}
+
+// list returns the built list, with aggregates under the monoid if it isn't nil.
+func (b *builder[K, V]) list(monoid *Monoid[V]) *SkipList[K, V] {
+	if monoid != nil {
+		m := *monoid
+		b.sl.monoid = &m
+		b.sl.rebuildAggregates()
+	}
+	return b.sl
+}
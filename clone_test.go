@@ -0,0 +1,79 @@
+package skiplist
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSkipList_Clone(t *testing.T) {
+	sl := NewSkipList[int, int]()
+	for i := 0; i < 1000; i++ {
+		sl.Set(i, i)
+	}
+	sl.SetMonoid(Monoid[int]{0, func(a, b int) int { return a + b }})
+
+	c := sl.Clone()
+	if err := c.Validate(); err != nil {
+		t.Fatalf("clone: %v", err)
+	}
+	if !c.Equal(sl, func(a, b int) bool { return a == b }) {
+		t.Errorf("clone: want equal to original")
+	}
+	for x, y := sl.header, c.header; x != nil; x, y = x.forward[0], y.forward[0] {
+		if len(x.forward) != len(y.forward) {
+			t.Fatalf("clone: node %v has %d levels, want %d", y, len(y.forward), len(x.forward))
+		}
+	}
+	if c.MaxLevel() != sl.MaxLevel() || c.level != sl.level || c.Last().Key() != 999 {
+		t.Errorf("clone: want max level %d and level %d, got %d and %d", sl.MaxLevel(), sl.level, c.MaxLevel(), c.level)
+	}
+	if got := c.Aggregate(0, 10); got != 45 {
+		t.Errorf("clone aggregate: want 45, got %d", got)
+	}
+
+	c.Set(5, 50)
+	if v, _ := sl.Get(5); v != 5 {
+		t.Errorf("clone: original changed to %d", v)
+	}
+	if c.Equal(sl, func(a, b int) bool { return a == b }) {
+		t.Errorf("clone: want different values to be unequal")
+	}
+	c.Set(5, 5)
+	c.Delete(999)
+	if c.Equal(sl, func(a, b int) bool { return a == b }) || sl.Equal(c, func(a, b int) bool { return true }) {
+		t.Errorf("clone: want different keys to be unequal")
+	}
+	if empty := NewSkipList[int, int]().Clone(); empty.Len() != 0 || empty.Last() != nil || empty.Validate() != nil {
+		t.Errorf("clone: want an empty, valid clone of an empty list")
+	}
+}
+
+func TestSkipList_EqualConcurrent(t *testing.T) {
+	a, b := NewSkipList[int, int](), NewSkipList[int, int]()
+	eq := func(x, y int) bool { return x == y }
+
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				switch w {
+				case 0:
+					a.Equal(b, eq)
+				case 1:
+					b.Equal(a, eq)
+				case 2:
+					a.Set(i, i)
+				case 3:
+					b.Set(i, i)
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	if !a.Equal(b, eq) {
+		t.Errorf("equal concurrent: want equal lists")
+	}
+}
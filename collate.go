@@ -0,0 +1,125 @@
+package skiplist
+
+import (
+	"cmp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// NewDescendingSkipList initializes a skip list using a cmp.Ordered key type whose keys are in
+// descending order, so First returns the greatest key and iterators run from greatest to least.
+// Optionally include items with which to initialize the list. Uses default max level of 32.
+func NewDescendingSkipList[K cmp.Ordered, V any](items ...Pair[K, V]) *SkipList[K, V] {
+	return NewSkipListFunc(func(k1, k2 K) int { return cmp.Compare(k2, k1) }, items...)
+}
+
+// NewCaseInsensitiveSkipList initializes a skip list of string keys ordered without regard to
+// case, using Unicode simple case folding, so "apple" < "Banana" < "cherry". Keys that differ
+// only in case are still distinct, and are ordered by their bytes. Optionally include items with
+// which to initialize the list. Uses default max level of 32.
+func NewCaseInsensitiveSkipList[V any](items ...Pair[string, V]) *SkipList[string, V] {
+	return NewSkipListFunc(CompareFold, items...)
+}
+
+// NewNaturalSkipList initializes a skip list of string keys in natural order, where runs of
+// digits are compared by their numeric value, so "file2" < "file10". The order doesn't depend on
+// the locale. Keys that are equal in natural order, like "a01" and "a1", are still distinct, and
+// are ordered by their bytes. Optionally include items with which to initialize the list. Uses
+// default max level of 32.
+func NewNaturalSkipList[V any](items ...Pair[string, V]) *SkipList[string, V] {
+	return NewSkipListFunc(CompareNatural, items...)
+}
+
+// NewCollatedSkipList initializes a skip list of string keys ordered by compare after each key is
+// passed through normalize, e.g. norm.NFC.String from golang.org/x/text/unicode/norm, so that
+// canonically equivalent spellings of a key sort together. compare may be CompareFold or
+// CompareNatural, or nil for strings.Compare. Keys that are equal once normalized are still
+// distinct, and are ordered by their bytes. Every comparison normalizes both keys, so normalize
+// should be cheap for keys that are already normalized. Optionally include items with which to
+// initialize the list. Uses default max level of 32.
+func NewCollatedSkipList[V any](normalize func(string) string, compare func(string, string) int, items ...Pair[string, V]) *SkipList[string, V] {
+	if compare == nil {
+		compare = strings.Compare
+	}
+	return NewSkipListFunc(func(s1, s2 string) int {
+		if c := compare(normalize(s1), normalize(s2)); c != 0 {
+			return c
+		}
+		return strings.Compare(s1, s2)
+	}, items...)
+}
+
+// CompareFold compares two strings like strings.Compare, but ignoring case under Unicode simple
+// case folding, as strings.EqualFold does. Strings that are equal ignoring case are compared by
+// their bytes, so CompareFold only returns 0 for identical strings.
+func CompareFold(s1, s2 string) int {
+	for i, j := 0, 0; i < len(s1) || j < len(s2); {
+		if i == len(s1) {
+			return -1
+		}
+		if j == len(s2) {
+			return 1
+		}
+		r1, n1 := utf8.DecodeRuneInString(s1[i:])
+		r2, n2 := utf8.DecodeRuneInString(s2[j:])
+		if c := cmp.Compare(foldRune(r1), foldRune(r2)); c != 0 {
+			return c
+		}
+		i, j = i+n1, j+n2
+	}
+	return strings.Compare(s1, s2)
+}
+
+// foldRune maps a rune to the smallest rune in its case folding orbit, so that every case of a
+// letter maps to the same rune.
+func foldRune(r rune) rune {
+	min := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < min {
+			min = f
+		}
+	}
+	return min
+}
+
+// CompareNatural compares two strings in natural order: runs of ASCII digits are compared by
+// their numeric value, however long they are, and everything else by bytes. Strings that are
+// equal in natural order, like "a01" and "a1", are compared by their bytes, so CompareNatural only
+// returns 0 for identical strings.
+func CompareNatural(s1, s2 string) int {
+	i, j := 0, 0
+	for i < len(s1) && j < len(s2) {
+		if !isDigit(s1[i]) || !isDigit(s2[j]) {
+			if c := cmp.Compare(s1[i], s2[j]); c != 0 {
+				return c
+			}
+			i, j = i+1, j+1
+			continue
+		}
+
+		start1, start2 := i, j
+		for i < len(s1) && isDigit(s1[i]) {
+			i++
+		}
+		for j < len(s2) && isDigit(s2[j]) {
+			j++
+		}
+		n1 := strings.TrimLeft(s1[start1:i], "0")
+		n2 := strings.TrimLeft(s2[start2:j], "0")
+		if c := cmp.Compare(len(n1), len(n2)); c != 0 {
+			return c
+		}
+		if c := strings.Compare(n1, n2); c != 0 {
+			return c
+		}
+	}
+	if c := cmp.Compare(len(s1)-i, len(s2)-j); c != 0 {
+		return c
+	}
+	return strings.Compare(s1, s2)
+}
+
+func isDigit(b byte) bool {
+	return '0' <= b && b <= '9'
+}
@@ -0,0 +1,79 @@
+package skiplist
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func keys[K, V any](sl *SkipList[K, V]) []K {
+	var res []K
+	for it := sl.Iterator(); it.Next(); {
+		res = append(res, it.Key())
+	}
+	return res
+}
+
+func TestNewDescendingSkipList(t *testing.T) {
+	sl := NewDescendingSkipList(Pair[int, string]{1, "one"}, Pair[int, string]{3, "three"}, Pair[int, string]{2, "two"})
+
+	if got, want := keys(sl), []int{3, 2, 1}; !slices.Equal(got, want) {
+		t.Errorf("descending: want %v, got %v", want, got)
+	}
+	var got []int
+	for it := sl.Range(3, 1); it.Next(); {
+		got = append(got, it.Key())
+	}
+	if want := []int{3, 2}; !slices.Equal(got, want) {
+		t.Errorf("descending range: want %v, got %v", want, got)
+	}
+}
+
+func TestNewCaseInsensitiveSkipList(t *testing.T) {
+	sl := NewCaseInsensitiveSkipList[int]()
+	for i, key := range []string{"cherry", "Banana", "apple", "banana", "Äpfel", "äpfel", "Zebra"} {
+		sl.Set(key, i)
+	}
+
+	want := []string{"apple", "Banana", "banana", "cherry", "Zebra", "Äpfel", "äpfel"}
+	if got := keys(sl); !slices.Equal(got, want) {
+		t.Errorf("case insensitive: want %v, got %v", want, got)
+	}
+	if CompareFold("straße", "STRASSE") == 0 || CompareFold("ΣΑΣ", "σας") >= 0 || CompareFold("abc", "ABC") <= 0 {
+		t.Error("compare fold: unexpected results")
+	}
+}
+
+func TestNewCollatedSkipList(t *testing.T) {
+	// a stand-in for norm.NFC.String that composes a single accent
+	compose := strings.NewReplacer("e\u0301", "\u00e9").Replace
+	sl := NewCollatedSkipList[int](compose, nil)
+	for i, key := range []string{"caf\u00e9", "cafg", "cafe\u0301"} {
+		sl.Set(key, i)
+	}
+
+	want := []string{"cafg", "cafe\u0301", "caf\u00e9"}
+	if got := keys(sl); !slices.Equal(got, want) {
+		t.Errorf("collated: want %q, got %q", want, got)
+	}
+
+	sl = NewCollatedSkipList[int](compose, CompareFold)
+	for i, key := range []string{"CAF\u00c9", "cafe\u0301", "Cafg"} {
+		sl.Set(key, i)
+	}
+	if got, want := keys(sl), []string{"Cafg", "CAF\u00c9", "cafe\u0301"}; !slices.Equal(got, want) {
+		t.Errorf("collated fold: want %q, got %q", want, got)
+	}
+}
+
+func TestNewNaturalSkipList(t *testing.T) {
+	sl := NewNaturalSkipList[int]()
+	for i, key := range []string{"file10", "file2", "file1", "file02", "file", "file10a", "file10b2", "file10b10", "99999999999999999999x"} {
+		sl.Set(key, i)
+	}
+
+	want := []string{"99999999999999999999x", "file", "file1", "file02", "file2", "file10", "file10a", "file10b2", "file10b10"}
+	if got := keys(sl); !slices.Equal(got, want) {
+		t.Errorf("natural: want %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,119 @@
+package skiplist
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Keys returns the keys of the skip list in order.
+// Time complexity: O(N), where N is the number of elements in the skip list.
+func (sl *SkipList[K, V]) Keys() []K {
+	sl.rw.RLock()
+	defer sl.rw.RUnlock()
+
+	keys := make([]K, 0, sl.size)
+	for x := sl.header.forward[0]; x != nil; x = x.forward[0] {
+		keys = append(keys, x.key)
+	}
+	return keys
+}
+
+// Values returns the values of the skip list in the order of their keys.
+// Time complexity: O(N), where N is the number of elements in the skip list.
+func (sl *SkipList[K, V]) Values() []V {
+	sl.rw.RLock()
+	defer sl.rw.RUnlock()
+
+	vals := make([]V, 0, sl.size)
+	for x := sl.header.forward[0]; x != nil; x = x.forward[0] {
+		vals = append(vals, x.val)
+	}
+	return vals
+}
+
+// ToSlice returns the elements of the skip list in order.
+// Time complexity: O(N), where N is the number of elements in the skip list.
+func (sl *SkipList[K, V]) ToSlice() []Pair[K, V] {
+	sl.rw.RLock()
+	defer sl.rw.RUnlock()
+
+	pairs := make([]Pair[K, V], 0, sl.size)
+	for x := sl.header.forward[0]; x != nil; x = x.forward[0] {
+		pairs = append(pairs, Pair[K, V]{x.key, x.val})
+	}
+	return pairs
+}
+
+// ToMap returns a map with the elements of the skip list, whose keys must be comparable.
+// Time complexity: O(N), where N is the number of elements in the skip list.
+func ToMap[K comparable, V any](sl *SkipList[K, V]) map[K]V {
+	sl.rw.RLock()
+	defer sl.rw.RUnlock()
+
+	m := make(map[K]V, sl.size)
+	for x := sl.header.forward[0]; x != nil; x = x.forward[0] {
+		m[x.key] = x.val
+	}
+	return m
+}
+
+// FromSortedPairs initializes a skip list using a cmp.Ordered key type from pairs sorted by key,
+// linking the nodes in one pass without searching. Panics if the keys are not strictly increasing.
+// Time complexity: O(N), where N is the number of pairs.
+func FromSortedPairs[K cmp.Ordered, V any](pairs []Pair[K, V]) *SkipList[K, V] {
+	return FromSortedPairsFunc(cmp.Compare[K], pairs)
+}
+
+// FromSortedPairsFunc is FromSortedPairs for a key type ordered by a three-way comparison
+// function, as for NewSkipListFunc.
+func FromSortedPairsFunc[K, V any](compare func(K, K) int, pairs []Pair[K, V]) *SkipList[K, V] {
+	sl := NewSkipListFunc[K, V](compare)
+	if !sl.sorted(pairs) {
+		panic("skiplist: FromSortedPairs called with keys that are not strictly increasing")
+	}
+	sl.setSorted(pairs)
+	return sl
+}
+
+// FromMap initializes a skip list using a cmp.Ordered key type with the elements of a map. The
+// keys are sorted first, and the nodes then linked in one pass without searching, as by SetAll.
+// Time complexity: O(NlogN) to sort the keys, where N is the number of elements in the map.
+func FromMap[K cmp.Ordered, V any](m map[K]V) *SkipList[K, V] {
+	pairs := make([]Pair[K, V], 0, len(m))
+	for key, val := range m {
+		pairs = append(pairs, Pair[K, V]{key, val})
+	}
+	slices.SortFunc(pairs, func(p1, p2 Pair[K, V]) int { return cmp.Compare(p1.key, p2.key) })
+	return NewSkipList(pairs...)
+}
+
+// sorted returns true if the keys of the pairs are strictly increasing.
+func (sl *SkipList[K, V]) sorted(pairs []Pair[K, V]) bool {
+	for i := 1; i < len(pairs); i++ {
+		if sl.compare(pairs[i-1].key, pairs[i].key) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// setSorted inserts pairs with strictly increasing keys into the empty list by appending nodes on
+// random levels, and then does what insertNode would have done for each of them. It must be
+// called with the write lock held, if the list is shared.
+func (sl *SkipList[K, V]) setSorted(pairs []Pair[K, V]) {
+	if len(pairs) == 0 {
+		return
+	}
+	b := sl.appender()
+	for _, p := range pairs {
+		b.push(p.key, p.val)
+	}
+	sl.modCount++
+	if sl.monoid != nil {
+		sl.rebuildAggregates()
+	}
+	for _, p := range pairs {
+		sl.logWrite(p.key)
+		sl.publish(Event[K, V]{Type: EventInsert, Key: p.key, Value: p.val})
+	}
+}
@@ -0,0 +1,74 @@
+package skiplist
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestSkipList_Conversions(t *testing.T) {
+	sl := NewSkipList(NewPair(3, "c"), NewPair(1, "a"), NewPair(2, "b"))
+
+	if got, want := sl.Keys(), []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("keys: want %v, got %v", want, got)
+	}
+	if got, want := sl.Values(), []string{"a", "b", "c"}; !slices.Equal(got, want) {
+		t.Errorf("values: want %v, got %v", want, got)
+	}
+	if got, want := sl.ToSlice(), []Pair[int, string]{{1, "a"}, {2, "b"}, {3, "c"}}; !slices.Equal(got, want) {
+		t.Errorf("to slice: want %v, got %v", want, got)
+	}
+	m := map[int]string{1: "a", 2: "b", 3: "c"}
+	if got := ToMap(sl); !maps.Equal(got, m) {
+		t.Errorf("to map: want %v, got %v", m, got)
+	}
+	if got := FromMap(m); got.Validate() != nil || !got.Equal(sl, func(a, b string) bool { return a == b }) {
+		t.Errorf("from map: want %v, got %v", sl.ToSlice(), got.ToSlice())
+	}
+	if got := NewSkipList[int, string]().Keys(); got == nil || len(got) != 0 {
+		t.Errorf("keys of empty list: want an empty slice, got %v", got)
+	}
+}
+
+func TestFromSortedPairs(t *testing.T) {
+	pairs := make([]Pair[int, int], 1000)
+	for i := range pairs {
+		pairs[i] = NewPair(i, i)
+	}
+
+	sl := FromSortedPairs(pairs)
+	if err := sl.Validate(); err != nil {
+		t.Fatalf("from sorted pairs: %v", err)
+	}
+	if sl.Len() != 1000 || sl.First().Key() != 0 || sl.Last().Key() != 999 {
+		t.Errorf("from sorted pairs: want 0 to 999, got %d from %v to %v", sl.Len(), sl.First(), sl.Last())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("from sorted pairs: want a panic for unsorted keys")
+		}
+	}()
+	FromSortedPairs([]Pair[int, int]{{1, 1}, {1, 1}})
+}
+
+func TestSkipList_SetAllSorted(t *testing.T) {
+	sl := NewSkipList[int, int]()
+	sl.SetMonoid(Monoid[int]{0, func(a, b int) int { return a + b }})
+	events, cancel := sl.Watch(0, 10)
+	defer cancel()
+
+	sl.SetAll([]Pair[int, int]{{1, 1}, {2, 2}, {3, 3}})
+	sl.SetAll([]Pair[int, int]{{5, 5}, {4, 4}, {5, 50}})
+	if err := sl.Validate(); err != nil {
+		t.Fatalf("set all sorted: %v", err)
+	}
+	if got := sl.Aggregate(0, 10); got != 60 {
+		t.Errorf("set all sorted aggregate: want 60, got %d", got)
+	}
+	for _, key := range []int{1, 2, 3, 5, 4} {
+		if e := <-events; e.Type != EventInsert || e.Key != key {
+			t.Errorf("set all sorted: want insert of %d, got %v", key, e)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package skiplist
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// DOTOptions configures how WriteDOT renders a skip list.
+type DOTOptions[K, V any] struct {
+	// Format returns the label of a node. If nil, nodes are labelled "key: value".
+	Format func(K, V) string
+
+	// NodeLimit is the maximum number of nodes rendered, counting from the first. Links to the
+	// nodes after it point to a single node labelled "...". Zero means no limit.
+	NodeLimit int
+}
+
+// WriteDOT writes a Graphviz graph of the skip list to w, which can be rendered with e.g.
+// "dot -Tsvg". Each node is drawn as a tower with one cell per level, from the header on the left
+// to NIL on the right, with a solid edge for each forward link and a dashed edge for each
+// backward link. Unlike String, the drawing stays aligned whatever the widths of the keys.
+// Time complexity: O(N), where N is the number of elements rendered.
+func (sl *SkipList[K, V]) WriteDOT(w io.Writer, opts DOTOptions[K, V]) error {
+	sl.rw.RLock()
+	defer sl.rw.RUnlock()
+
+	format := opts.Format
+	if format == nil {
+		format = func(key K, val V) string {
+			return fmt.Sprintf("%v: %v", key, val)
+		}
+	}
+
+	ids := map[*slNode[K, V]]string{sl.header: "header"}
+	var nodes []*slNode[K, V]
+	for x := sl.header.forward[0]; x != nil; x = x.forward[0] {
+		if opts.NodeLimit > 0 && len(nodes) == opts.NodeLimit {
+			break
+		}
+		ids[x] = fmt.Sprintf("n%d", len(nodes))
+		nodes = append(nodes, x)
+	}
+	truncated := len(nodes) < sl.size
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "digraph skiplist {")
+	fmt.Fprintln(bw, "\trankdir=LR;")
+	fmt.Fprintln(bw, "\tnode [shape=record, fontname=monospace];")
+
+	writeTower := func(id string, levels int, label string) {
+		cells := make([]string, 0, levels+1)
+		for i := levels - 1; i >= 0; i-- {
+			cells = append(cells, fmt.Sprintf("<l%d> ", i))
+		}
+		cells = append(cells, dotEscape(label))
+		fmt.Fprintf(bw, "\t%s [label=\"%s\"];\n", id, strings.Join(cells, "|"))
+	}
+
+	writeTower("header", sl.level+1, "HEAD")
+	for _, x := range nodes {
+		writeTower(ids[x], len(x.forward), format(x.key, x.val))
+	}
+	writeTower("nil", sl.level+1, "NIL")
+	if truncated {
+		writeTower("more", sl.level+1, "...")
+	}
+
+	for _, x := range append([]*slNode[K, V]{sl.header}, nodes...) {
+		for i := 0; i <= x.level() && i <= sl.level; i++ {
+			to := "nil"
+			if next := x.forward[i]; next != nil {
+				if id, ok := ids[next]; ok {
+					to = id
+				} else {
+					to = "more"
+				}
+			}
+			fmt.Fprintf(bw, "\t%s:l%d -> %s:l%d;\n", ids[x], i, to, i)
+		}
+		if x.backward != nil {
+			fmt.Fprintf(bw, "\t%s:l0 -> %s:l0 [style=dashed, constraint=false];\n", ids[x], ids[x.backward])
+		}
+	}
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+// dotEscape escapes the characters that have a meaning in a Graphviz record label.
+func dotEscape(label string) string {
+	var bldr strings.Builder
+	for _, r := range label {
+		switch r {
+		case '"', '\\', '{', '}', '|', '<', '>':
+			bldr.WriteRune('\\')
+		case '\n':
+			bldr.WriteString("\\n")
+			continue
+		}
+		bldr.WriteRune(r)
+	}
+	return bldr.String()
+}
@@ -0,0 +1,56 @@
+package skiplist
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestSkipList_WriteDOT(t *testing.T) {
+	sl := NewSkipList[int, string]()
+	for i := 0; i < 20; i++ {
+		sl.Set(i, "v")
+	}
+	sl.Set(3, `a "quoted" {value}`)
+
+	var buf bytes.Buffer
+	if err := sl.WriteDOT(&buf, DOTOptions[int, string]{}); err != nil {
+		t.Fatalf("write dot: %v", err)
+	}
+	dot := buf.String()
+
+	for _, want := range []string{
+		"digraph skiplist {",
+		`n0 [label="<l`,
+		`3: a \"quoted\" \{value\}`,
+		"header:l0 -> n0:l0;",
+		"n19:l0 -> nil:l0;",
+		"n1:l0 -> n0:l0 [style=dashed",
+	} {
+		if !strings.Contains(dot, want) {
+			t.Errorf("write dot: missing %q in\n%s", want, dot)
+		}
+	}
+	if strings.Contains(dot, "more") {
+		t.Error("write dot: truncated without a node limit")
+	}
+	if n := strings.Count(dot, "-> nil:l"); n != sl.level+1 {
+		t.Errorf("write dot: want %d links to nil, got %d", sl.level+1, n)
+	}
+
+	buf.Reset()
+	err := sl.WriteDOT(&buf, DOTOptions[int, string]{
+		Format:    func(key int, val string) string { return "key" },
+		NodeLimit: 5,
+	})
+	if err != nil {
+		t.Fatalf("write dot with limit: %v", err)
+	}
+	dot = buf.String()
+	if strings.Contains(dot, "n5 ") || !strings.Contains(dot, "n4:l0 -> more:l0;") {
+		t.Errorf("write dot with limit: want 5 nodes and a link to more, got\n%s", dot)
+	}
+	if strings.Contains(dot, ": v") {
+		t.Error("write dot with limit: format function not used")
+	}
+}
@@ -0,0 +1,99 @@
+package skiplist
+
+// Filter returns a new skip list with the elements of sl for which pred returns true, with the same
+// comparator, max level and monoid. The result is built in order without searching.
+// Time complexity: O(N), where N is the number of elements in sl.
+func Filter[K, V any](sl *SkipList[K, V], pred func(K, V) bool) *SkipList[K, V] {
+	sl.rw.RLock()
+	defer sl.rw.RUnlock()
+
+	b := newBuilder[V](sl)
+	for x := sl.header.forward[0]; x != nil; x = x.forward[0] {
+		if pred(x.key, x.val) {
+			b.push(x.key, x.val)
+		}
+	}
+	return b.list(sl.monoid)
+}
+
+// Partition returns two new skip lists, the first with the elements of sl for which pred returns
+// true and the second with the rest, each with the same comparator, max level and monoid as sl.
+// Time complexity: O(N), where N is the number of elements in sl.
+func Partition[K, V any](sl *SkipList[K, V], pred func(K, V) bool) (*SkipList[K, V], *SkipList[K, V]) {
+	sl.rw.RLock()
+	defer sl.rw.RUnlock()
+
+	in, out := newBuilder[V](sl), newBuilder[V](sl)
+	for x := sl.header.forward[0]; x != nil; x = x.forward[0] {
+		if pred(x.key, x.val) {
+			in.push(x.key, x.val)
+		} else {
+			out.push(x.key, x.val)
+		}
+	}
+	return in.list(sl.monoid), out.list(sl.monoid)
+}
+
+// MapValues returns a new skip list with the same keys as sl, each with the value fn returns for
+// it, and the same comparator, max level and node levels. The result has no monoid.
+// Time complexity: O(N), where N is the number of elements in sl.
+func MapValues[K, V, W any](sl *SkipList[K, V], fn func(K, V) W) *SkipList[K, W] {
+	sl.rw.RLock()
+	defer sl.rw.RUnlock()
+
+	b := newBuilder[W](sl)
+	for x := sl.header.forward[0]; x != nil; x = x.forward[0] {
+		b.add(x.level(), x.key, fn(x.key, x.val))
+	}
+	return b.list(nil)
+}
+
+// Fold combines the elements of sl in order, starting from init and calling fn with the result so
+// far and each element in turn, and returns the final result. fn must not modify the list.
+// Time complexity: O(N), where N is the number of elements in sl.
+func Fold[K, V, A any](sl *SkipList[K, V], init A, fn func(acc A, key K, val V) A) A {
+	sl.rw.RLock()
+	defer sl.rw.RUnlock()
+
+	acc := init
+	for x := sl.header.forward[0]; x != nil; x = x.forward[0] {
+		acc = fn(acc, x.key, x.val)
+	}
+	return acc
+}
+
+// DeleteFunc removes every element for which pred returns true, in a single pass over the list,
+// and returns the number removed. pred must not modify the list.
+// Time complexity: O(N), where N is the number of elements in the skip list.
+func (sl *SkipList[K, V]) DeleteFunc(pred func(K, V) bool) int {
+	sl.rw.Lock()
+	defer sl.rw.Unlock()
+
+	// update holds the last remaining node on each level, which are the predecessors of the next
+	// node on every level it's on
+	update := make([]*slNode[K, V], len(sl.header.forward))
+	for i := range update {
+		update[i] = sl.header
+	}
+	n := 0
+	for x := sl.header.forward[0]; x != nil; {
+		next := x.forward[0]
+		if pred(x.key, x.val) {
+			sl.removeNode(update, x)
+			n++
+		} else {
+			for i := 0; i <= x.level(); i++ {
+				update[i] = x
+			}
+		}
+		x = next
+	}
+	return n
+}
+
+// Retain removes every element for which pred returns false, in a single pass over the list, and
+// returns the number removed. pred must not modify the list.
+// Time complexity: O(N), where N is the number of elements in the skip list.
+func (sl *SkipList[K, V]) Retain(pred func(K, V) bool) int {
+	return sl.DeleteFunc(func(key K, val V) bool { return !pred(key, val) })
+}
@@ -0,0 +1,80 @@
+package skiplist
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestFilterPartition(t *testing.T) {
+	sl := NewSkipList[int, int]()
+	for i := 0; i < 100; i++ {
+		sl.Set(i, i*i)
+	}
+	even := func(k, _ int) bool { return k%2 == 0 }
+
+	f := Filter(sl, even)
+	in, out := Partition(sl, even)
+	for _, l := range []*SkipList[int, int]{f, in, out} {
+		if err := l.Validate(); err != nil {
+			t.Fatalf("filter: %v", err)
+		}
+	}
+	if f.Len() != 50 || f.Last().Key() != 98 || !f.Equal(in, func(a, b int) bool { return a == b }) {
+		t.Errorf("filter: want the 50 even keys, got %d up to %v", f.Len(), f.Last())
+	}
+	if out.Len() != 50 || out.First().Key() != 1 || out.Last().Key() != 99 {
+		t.Errorf("partition: want the 50 odd keys, got %d from %v to %v", out.Len(), out.First(), out.Last())
+	}
+	if sl.Len() != 100 {
+		t.Errorf("filter: original changed to %d elements", sl.Len())
+	}
+}
+
+func TestMapValuesFold(t *testing.T) {
+	sl := NewSkipList[int, int]()
+	for i := 1; i <= 10; i++ {
+		sl.Set(i, i)
+	}
+
+	m := MapValues(sl, func(k, v int) string { return strconv.Itoa(k * v) })
+	if err := m.Validate(); err != nil {
+		t.Fatalf("map values: %v", err)
+	}
+	if v, ok := m.Get(4); !ok || v != "16" || m.Len() != 10 {
+		t.Errorf("map values: want 16, got %q", v)
+	}
+
+	sum := Fold(sl, 0, func(acc, _, v int) int { return acc + v })
+	order := Fold(m, "", func(acc string, _ int, v string) string { return acc + v + " " })
+	if sum != 55 || order != "1 4 9 16 25 36 49 64 81 100 " {
+		t.Errorf("fold: want 55 and the squares in order, got %d and %q", sum, order)
+	}
+}
+
+func TestSkipList_DeleteFunc(t *testing.T) {
+	sl := NewSkipList[int, int]()
+	for i := 0; i < 1000; i++ {
+		sl.Set(i, i)
+	}
+	sl.SetMonoid(Monoid[int]{0, func(a, b int) int { return a + b }})
+
+	if n := sl.DeleteFunc(func(k, _ int) bool { return k%3 != 0 }); n != 666 {
+		t.Errorf("delete func: want 666 removed, got %d", n)
+	}
+	if n := sl.Retain(func(k, _ int) bool { return k < 30 }); n != 324 {
+		t.Errorf("retain: want 324 removed, got %d", n)
+	}
+	if err := sl.Validate(); err != nil {
+		t.Fatalf("delete func: %v", err)
+	}
+	if got, want := keys(sl), []int{0, 3, 6, 9, 12, 15, 18, 21, 24, 27}; !slices.Equal(got, want) {
+		t.Errorf("delete func: want %v, got %v", want, got)
+	}
+	if got := sl.Aggregate(0, 100); got != 135 {
+		t.Errorf("delete func aggregate: want 135, got %d", got)
+	}
+	if sl.Retain(func(int, int) bool { return false }); sl.Len() != 0 || sl.Last() != nil {
+		t.Errorf("retain none: want an empty list, got %d elements", sl.Len())
+	}
+}
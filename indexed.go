@@ -0,0 +1,208 @@
+package skiplist
+
+import (
+	"cmp"
+	"sync"
+)
+
+// IndexedCollection stores records by ID in a primary skip list and maintains any number of
+// secondary indexes over them, each ordering the records by a key extracted from them. Every
+// insert, update and delete changes the primary list and all of the indexes under one lock, so
+// readers never see them disagree.
+type IndexedCollection[ID, T any] struct {
+	mu      sync.RWMutex
+	primary *SkipList[ID, T]
+	indexes []indexer[ID, T]
+}
+
+// indexer is the part of an Index that the collection uses to keep it up to date.
+type indexer[ID, T any] interface {
+	insert(id ID, rec T)
+	remove(id ID, rec T)
+}
+
+// NewIndexedCollection returns an empty collection with a cmp.Ordered ID type.
+func NewIndexedCollection[ID cmp.Ordered, T any]() *IndexedCollection[ID, T] {
+	return NewIndexedCollectionFunc[ID, T](cmp.Compare[ID])
+}
+
+// NewIndexedCollectionFunc returns an empty collection with IDs ordered by a three-way comparison
+// function, as for NewSkipListFunc.
+func NewIndexedCollectionFunc[ID, T any](compare func(ID, ID) int) *IndexedCollection[ID, T] {
+	return &IndexedCollection[ID, T]{primary: NewSkipListFunc[ID, T](compare)}
+}
+
+// Len returns the number of records in the collection.
+func (c *IndexedCollection[ID, T]) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.primary.Len()
+}
+
+// Get returns the record with the given ID if it exists and a bool indicating if it does.
+func (c *IndexedCollection[ID, T]) Get(id ID) (T, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.primary.Get(id)
+}
+
+// Set inserts the record with the given ID, or replaces the existing one, and updates every index.
+// Returns true if the record was newly inserted, or false and the old record if it was replaced.
+func (c *IndexedCollection[ID, T]) Set(id ID, rec T) (bool, T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	inserted, old := c.primary.Set(id, rec)
+	for _, idx := range c.indexes {
+		if !inserted {
+			idx.remove(id, old)
+		}
+		idx.insert(id, rec)
+	}
+	return inserted, old
+}
+
+// Delete removes the record with the given ID from the collection and every index. Returns the
+// deleted record if it existed and a bool indicating if it did.
+func (c *IndexedCollection[ID, T]) Delete(id ID) (T, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rec, ok := c.primary.Delete(id)
+	if ok {
+		for _, idx := range c.indexes {
+			idx.remove(id, rec)
+		}
+	}
+	return rec, ok
+}
+
+// Range calls fn for each record with an ID within the bounds, in ID order, until fn returns
+// false. fn must not modify the collection.
+func (c *IndexedCollection[ID, T]) Range(bounds Bounds[ID], fn func(id ID, rec T) bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for it := c.primary.Iter(bounds); it.Next(); {
+		if !fn(it.Key(), it.Value()) {
+			return
+		}
+	}
+}
+
+// Index is a secondary index of an IndexedCollection, which orders its records by a key extracted
+// from each of them. Several records may have the same key, in which case they're ordered by ID.
+type Index[ID, T, IK any] struct {
+	coll      *IndexedCollection[ID, T]
+	extract   func(T) IK
+	compareIK func(IK, IK) int
+	entries   *SkipList[indexEntry[IK, ID], T]
+}
+
+// indexEntry is the key of a record in an index: the extracted key, made unique by the record's ID.
+type indexEntry[IK, ID any] struct {
+	key IK
+	id  ID
+}
+
+// AddIndex adds a secondary index over the collection that orders records by the cmp.Ordered key
+// that extract returns for each of them. Records already in the collection are indexed
+// immediately. Time complexity: O(NlogN), where N is the number of records.
+func AddIndex[ID, T any, IK cmp.Ordered](c *IndexedCollection[ID, T], extract func(T) IK) *Index[ID, T, IK] {
+	return AddIndexFunc(c, extract, cmp.Compare[IK])
+}
+
+// AddIndexFunc adds a secondary index over the collection that orders records by the key that
+// extract returns for each of them, under a three-way comparison function.
+func AddIndexFunc[ID, T, IK any](c *IndexedCollection[ID, T], extract func(T) IK, compare func(IK, IK) int) *Index[ID, T, IK] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	compareID := c.primary.compare
+	idx := &Index[ID, T, IK]{
+		coll:      c,
+		extract:   extract,
+		compareIK: compare,
+		entries: NewSkipListFunc[indexEntry[IK, ID], T](func(e1, e2 indexEntry[IK, ID]) int {
+			if c := compare(e1.key, e2.key); c != 0 {
+				return c
+			}
+			return compareID(e1.id, e2.id)
+		}),
+	}
+	for it := c.primary.Iterator(); it.Next(); {
+		idx.insert(it.Key(), it.Value())
+	}
+	c.indexes = append(c.indexes, idx)
+	return idx
+}
+
+func (idx *Index[ID, T, IK]) insert(id ID, rec T) {
+	idx.entries.Set(indexEntry[IK, ID]{idx.extract(rec), id}, rec)
+}
+
+func (idx *Index[ID, T, IK]) remove(id ID, rec T) {
+	idx.entries.Delete(indexEntry[IK, ID]{idx.extract(rec), id})
+}
+
+// Len returns the number of records in the index, which is the number in the collection.
+func (idx *Index[ID, T, IK]) Len() int {
+	idx.coll.mu.RLock()
+	defer idx.coll.mu.RUnlock()
+
+	return idx.entries.Len()
+}
+
+// Find returns the records with the given key, in ID order.
+func (idx *Index[ID, T, IK]) Find(key IK) []T {
+	var res []T
+	idx.Range(Bounds[IK]{Inclusive(key), Inclusive(key)}, func(_ IK, _ ID, rec T) bool {
+		res = append(res, rec)
+		return true
+	})
+	return res
+}
+
+// Range calls fn for each record with a key within the bounds, in key order, until fn returns
+// false. fn must not modify the collection.
+func (idx *Index[ID, T, IK]) Range(bounds Bounds[IK], fn func(key IK, id ID, rec T) bool) {
+	idx.coll.mu.RLock()
+	defer idx.coll.mu.RUnlock()
+
+	it := idx.entries.iterWhere(idx.where(bounds))
+	for it.Next() {
+		if !fn(it.Key().key, it.Key().id, it.Value()) {
+			return
+		}
+	}
+}
+
+// Reverse calls fn for each record with a key within the bounds, in descending key order, until
+// fn returns false. fn must not modify the collection.
+func (idx *Index[ID, T, IK]) Reverse(bounds Bounds[IK], fn func(key IK, id ID, rec T) bool) {
+	idx.coll.mu.RLock()
+	defer idx.coll.mu.RUnlock()
+
+	it := idx.entries.iterWhere(idx.where(bounds))
+	for ok := it.SeekToLast(); ok; ok = it.Prev() {
+		if !fn(it.Key().key, it.Key().id, it.Value()) {
+			return
+		}
+	}
+}
+
+// where returns the function that places an entry before, within or after the bounds on its key,
+// for iterWhere.
+func (idx *Index[ID, T, IK]) where(bounds Bounds[IK]) func(indexEntry[IK, ID]) int {
+	return func(e indexEntry[IK, ID]) int {
+		if !bounds.Lower.admitsFromBelow(e.key, idx.compareIK) {
+			return -1
+		}
+		if !bounds.Upper.admitsFromAbove(e.key, idx.compareIK) {
+			return 1
+		}
+		return 0
+	}
+}
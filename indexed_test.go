@@ -0,0 +1,63 @@
+package skiplist
+
+import (
+	"slices"
+	"testing"
+)
+
+type user struct {
+	name  string
+	score int
+}
+
+func TestIndexedCollection(t *testing.T) {
+	c := NewIndexedCollection[int, user]()
+	c.Set(1, user{"ada", 30})
+	byName := AddIndex(c, func(u user) string { return u.name })
+	byScore := AddIndex(c, func(u user) int { return u.score })
+
+	c.Set(2, user{"bob", 10})
+	c.Set(3, user{"cy", 30})
+	c.Set(4, user{"dee", 20})
+	c.Set(2, user{"bob", 40})
+	c.Delete(4)
+	c.Delete(5)
+
+	ids := func(idx *Index[int, user, int], bounds Bounds[int]) []int {
+		var res []int
+		idx.Range(bounds, func(_ int, id int, _ user) bool {
+			res = append(res, id)
+			return true
+		})
+		return res
+	}
+
+	if got, want := ids(byScore, Bounds[int]{}), []int{1, 3, 2}; !slices.Equal(got, want) {
+		t.Errorf("index range: want %v, got %v", want, got)
+	}
+	if got, want := ids(byScore, Bounds[int]{Exclusive(10), Exclusive(40)}), []int{1, 3}; !slices.Equal(got, want) {
+		t.Errorf("index bounded range: want %v, got %v", want, got)
+	}
+	if got := byScore.Find(30); len(got) != 2 || got[0].name != "ada" || got[1].name != "cy" {
+		t.Errorf("index find: want ada and cy, got %v", got)
+	}
+	if got := byScore.Find(20); len(got) != 0 {
+		t.Errorf("index find deleted: want none, got %v", got)
+	}
+
+	var names []string
+	byName.Reverse(Bounds[string]{}, func(name string, _ int, _ user) bool {
+		names = append(names, name)
+		return true
+	})
+	if want := []string{"cy", "bob", "ada"}; !slices.Equal(names, want) {
+		t.Errorf("index reverse: want %v, got %v", want, names)
+	}
+
+	if c.Len() != 3 || byName.Len() != 3 || byScore.Len() != 3 {
+		t.Errorf("indexed len: want 3, got %d, %d and %d", c.Len(), byName.Len(), byScore.Len())
+	}
+	if u, ok := c.Get(2); !ok || u.score != 40 {
+		t.Errorf("indexed get: want bob with 40, got %v", u)
+	}
+}
@@ -1,6 +1,14 @@
 package skiplist
 
-// Iterator is a bidirectional iterator over the skip list.
+import "errors"
+
+// ErrConcurrentModification is reported by an iterator's Err method when the skip list was
+// structurally modified, i.e. a key was inserted or deleted, after the iterator was created.
+var ErrConcurrentModification = errors.New("skiplist: concurrent modification during iteration")
+
+// Iterator is a bidirectional iterator over the skip list. A new iterator is positioned between
+// elements, so the first call to Next or Prev moves it onto an element. The Seek methods move it
+// directly onto an element, after which Next and Prev move to its neighbours.
 type Iterator[K, V any] interface {
 	// Next returns true if there are further nodes over which to iterate and
 	// advances the iterator if there are
@@ -15,34 +23,188 @@ type Iterator[K, V any] interface {
 
 	// Value returns the current value
 	Value() V
+
+	// Err returns ErrConcurrentModification if Next or Prev stopped because the list was
+	// structurally modified since the iterator was created, or nil otherwise
+	Err() error
+
+	// Seek moves the iterator to the first element with key greater than or equal to key and
+	// returns true, or returns false if there is none. Time complexity: O(logN).
+	Seek(key K) bool
+
+	// SeekForPrev moves the iterator to the last element with key less than or equal to key and
+	// returns true, or returns false if there is none. Time complexity: O(logN).
+	SeekForPrev(key K) bool
+
+	// SeekToFirst moves the iterator to the first element and returns true, or returns false if
+	// there are no elements. Time complexity: O(1).
+	SeekToFirst() bool
+
+	// SeekToLast moves the iterator to the last element and returns true, or returns false if
+	// there are no elements. Time complexity: O(1).
+	SeekToLast() bool
+
+	// Valid returns true if the iterator is positioned on an element, i.e. Key and Value are
+	// meaningful
+	Valid() bool
+
+	// SetValue replaces the value of the current element. It does nothing if the iterator is not
+	// valid or the list was structurally modified by someone else since it was positioned.
+	SetValue(val V)
+
+	// Remove deletes the current element from the list without searching for it again. The
+	// iterator is left between the neighbours of the removed element, so Next moves to the element
+	// after it and Prev to the element before it. Like SetValue, it does nothing if the iterator is
+	// not valid or the list was modified by someone else.
+	Remove()
+}
+
+// Bound is one end of a range of keys: either a key that is included in or excluded from the
+// range, or no limit at all. The zero value is unbounded.
+type Bound[K any] struct {
+	key       K
+	inclusive bool
+	set       bool // false if the range is unbounded at this end
+}
+
+// Inclusive returns a bound that includes key in the range.
+func Inclusive[K any](key K) Bound[K] {
+	return Bound[K]{key: key, inclusive: true, set: true}
+}
+
+// Exclusive returns a bound that excludes key from the range.
+func Exclusive[K any](key K) Bound[K] {
+	return Bound[K]{key: key, set: true}
+}
+
+// Unbounded returns a bound that doesn't limit the range, so the range extends to the first or
+// last key of the list.
+func Unbounded[K any]() Bound[K] {
+	return Bound[K]{}
+}
+
+// admitsFromBelow returns true if key is not below b, taken as a lower bound.
+func (b Bound[K]) admitsFromBelow(key K, compare func(K, K) int) bool {
+	if !b.set {
+		return true
+	}
+	if b.inclusive {
+		return compare(key, b.key) >= 0
+	}
+	return compare(b.key, key) < 0
+}
+
+// admitsFromAbove returns true if key is not above b, taken as an upper bound.
+func (b Bound[K]) admitsFromAbove(key K, compare func(K, K) int) bool {
+	if !b.set {
+		return true
+	}
+	if b.inclusive {
+		return compare(b.key, key) >= 0
+	}
+	return compare(key, b.key) < 0
+}
+
+// Bounds is a range of keys from Lower up to Upper, e.g. Bounds[K]{Inclusive(x), Unbounded[K]()}
+// for every key greater than or equal to x. The zero value is the whole list.
+type Bounds[K any] struct {
+	Lower Bound[K]
+	Upper Bound[K]
 }
 
+// contains returns true if key is within the bounds.
+func (b Bounds[K]) contains(key K, compare func(K, K) int) bool {
+	return b.Lower.admitsFromBelow(key, compare) && b.Upper.admitsFromAbove(key, compare)
+}
+
+// iter is a fail-fast iterator: it remembers the list's modification count when created, and
+// stops with ErrConcurrentModification as soon as it sees that count change, rather than walking
+// nodes that may have been unlinked. The Seek methods search from the header again, so they are
+// safe after a modification and reset the iterator's error.
+//
+// The iterator is either on curr, or, if onNode is false, in the gap between curr and the node
+// after it. The header is never on, so a new iterator sits in the gap after the header. A range
+// iterator never moves onto a node outside of its lo and hi bounds, in either direction.
 type iter[K, V any] struct {
-	lessThan    func(K, K) bool
-	curr        *slNode[K, V]
-	rangeEndKey *K // if this is a range iterator, this is the key the iterator goes up to, exclusive
+	sl       *SkipList[K, V]
+	curr     *slNode[K, V]
+	onNode   bool
+	lo, hi   Bound[K]    // the range of keys the iterator may visit
+	where    func(K) int // if set, replaces lo and hi: <0 for keys before, 0 within and >0 after the range
+	modCount int         // the list's modCount when the iterator was created or last seeked
+	err      error
 }
 
-func (it *iter[K, V]) hasNext() bool {
-	if it.curr.forward[0] == nil {
-		return false
+// checkMod records ErrConcurrentModification and returns false if the list has been structurally
+// modified since the iterator was created. It must be called with the read lock held.
+func (it *iter[K, V]) checkMod() bool {
+	if it.err == nil && it.sl.modCount != it.modCount {
+		it.err = ErrConcurrentModification
+	}
+	return it.err == nil
+}
+
+// aboveLo returns true if the key is not below the iterator's lower bound.
+func (it *iter[K, V]) aboveLo(key K) bool {
+	if it.where != nil {
+		return it.where(key) >= 0
 	}
-	if it.rangeEndKey != nil {
-		return it.lessThan(it.curr.forward[0].key, *it.rangeEndKey)
+	return it.lo.admitsFromBelow(key, it.sl.compare)
+}
+
+// belowHi returns true if the key is not above the iterator's upper bound.
+func (it *iter[K, V]) belowHi(key K) bool {
+	if it.where != nil {
+		return it.where(key) <= 0
+	}
+	return it.hi.admitsFromAbove(key, it.sl.compare)
+}
+
+// inRange returns true if the node is an element within the iterator's range.
+func (it *iter[K, V]) inRange(x *slNode[K, V]) bool {
+	return x != nil && !x.isHeader && it.aboveLo(x.key) && it.belowHi(x.key)
+}
+
+// moveTo puts the iterator on x if x is within range, or otherwise in the gap after gap.
+func (it *iter[K, V]) moveTo(x, gap *slNode[K, V]) bool {
+	if it.inRange(x) {
+		it.curr, it.onNode = x, true
+	} else {
+		it.curr, it.onNode = gap, false
 	}
-	return true
+	return it.onNode
+}
+
+// reset clears a previous concurrent modification error, since the iterator is about to be
+// repositioned by a fresh search. It must be called with the read lock held.
+func (it *iter[K, V]) reset() {
+	it.modCount = it.sl.modCount
+	it.err = nil
 }
 
 func (it *iter[K, V]) Next() bool {
-	if it.hasNext() {
-		it.curr = it.curr.forward[0]
+	it.sl.rw.RLock()
+	defer it.sl.rw.RUnlock()
+
+	if it.checkMod() && it.inRange(it.curr.forward[0]) {
+		it.curr, it.onNode = it.curr.forward[0], true
 		return true
 	}
 	return false
 }
 
 func (it *iter[K, V]) Prev() bool {
-	if !it.curr.backward.isHeader {
+	it.sl.rw.RLock()
+	defer it.sl.rw.RUnlock()
+
+	if !it.checkMod() {
+		return false
+	}
+	if !it.onNode {
+		it.onNode = it.inRange(it.curr)
+		return it.onNode
+	}
+	if it.inRange(it.curr.backward) {
 		it.curr = it.curr.backward
 		return true
 	}
@@ -56,3 +218,132 @@ func (it *iter[K, V]) Key() K {
 func (it *iter[K, V]) Value() V {
 	return it.curr.val
 }
+
+func (it *iter[K, V]) Err() error {
+	return it.err
+}
+
+func (it *iter[K, V]) Seek(key K) bool {
+	it.sl.rw.RLock()
+	defer it.sl.rw.RUnlock()
+
+	it.reset()
+	if !it.aboveLo(key) {
+		return it.seekToFirst()
+	}
+	if !it.belowHi(key) {
+		it.curr, it.onNode = it.last(), false
+		return false
+	}
+	x := it.sl.searchBefore(key)
+	return it.moveTo(x.forward[0], x)
+}
+
+func (it *iter[K, V]) SeekForPrev(key K) bool {
+	it.sl.rw.RLock()
+	defer it.sl.rw.RUnlock()
+
+	it.reset()
+	if !it.belowHi(key) {
+		return it.seekToLast()
+	}
+	x := it.sl.searchBefore(key)
+	if next := x.forward[0]; next != nil && !it.sl.less(key, next.key) {
+		x = next
+	}
+	if it.moveTo(x, x) {
+		return true
+	}
+	it.curr = it.first()
+	return false
+}
+
+func (it *iter[K, V]) SeekToFirst() bool {
+	it.sl.rw.RLock()
+	defer it.sl.rw.RUnlock()
+
+	it.reset()
+	return it.seekToFirst()
+}
+
+func (it *iter[K, V]) SeekToLast() bool {
+	it.sl.rw.RLock()
+	defer it.sl.rw.RUnlock()
+
+	it.reset()
+	return it.seekToLast()
+}
+
+func (it *iter[K, V]) Valid() bool {
+	return it.onNode
+}
+
+func (it *iter[K, V]) SetValue(val V) {
+	it.sl.rw.Lock()
+	defer it.sl.rw.Unlock()
+
+	if it.checkMod() && it.onNode {
+		it.sl.updateNode(nil, it.curr, val)
+	}
+}
+
+func (it *iter[K, V]) Remove() {
+	it.sl.rw.Lock()
+	defer it.sl.rw.Unlock()
+
+	if !it.checkMod() || !it.onNode {
+		return
+	}
+	x := it.curr
+	it.sl.removeNode(it.sl.predecessors(x), x)
+	it.curr, it.onNode = x.backward, false
+	it.modCount = it.sl.modCount
+}
+
+// seekToFirst moves the iterator onto the first element in range. If there is none it is left in
+// the gap before the range.
+func (it *iter[K, V]) seekToFirst() bool {
+	x := it.first()
+	return it.moveTo(x.forward[0], x)
+}
+
+// seekToLast moves the iterator onto the last element in range. If there is none it is left in
+// the gap after the range.
+func (it *iter[K, V]) seekToLast() bool {
+	x := it.last()
+	return it.moveTo(x, x)
+}
+
+// first returns the node after which the iterator's range begins.
+func (it *iter[K, V]) first() *slNode[K, V] {
+	if it.where != nil {
+		return it.sl.searchFunc(func(key K) bool { return it.where(key) < 0 })
+	}
+	if !it.lo.set {
+		return it.sl.header
+	}
+	x := it.sl.searchBefore(it.lo.key)
+	if next := x.forward[0]; !it.lo.inclusive && next != nil && !it.sl.less(it.lo.key, next.key) {
+		x = next
+	}
+	return x
+}
+
+// last returns the last node at or before the end of the iterator's range, which is the header if
+// there is no such node.
+func (it *iter[K, V]) last() *slNode[K, V] {
+	if it.where != nil {
+		return it.sl.searchFunc(func(key K) bool { return it.where(key) <= 0 })
+	}
+	if !it.hi.set {
+		if it.sl.max == nil {
+			return it.sl.header
+		}
+		return it.sl.max
+	}
+	x := it.sl.searchBefore(it.hi.key)
+	if next := x.forward[0]; it.hi.inclusive && next != nil && !it.sl.less(it.hi.key, next.key) {
+		x = next
+	}
+	return x
+}
@@ -1,6 +1,7 @@
 package skiplist
 
 import (
+	"slices"
 	"testing"
 )
 
@@ -127,3 +128,308 @@ func TestIterator_Range(t *testing.T) {
 		t.Errorf("range: expected false but got %v", ok)
 	}
 }
+
+func TestIterator_ConcurrentModification(t *testing.T) {
+	items := []Pair[int, string]{
+		{-5, "beefcafe"},
+		{0, "foo"},
+		{1, "bar"},
+		{2, "bar"},
+		{4, "bing"},
+	}
+
+	sl := NewSkipList(items...)
+
+	it := sl.Iterator()
+	it.Next()
+	sl.Set(0, "updated")
+	if !it.Next() {
+		t.Errorf("iterator stopped after a value update: %v", it.Err())
+	}
+
+	sl.Delete(1)
+	if it.Next() {
+		t.Errorf("iterator advanced after a delete, now at key %v", it.Key())
+	}
+	if it.Err() != ErrConcurrentModification {
+		t.Errorf("expected %v, got %v", ErrConcurrentModification, it.Err())
+	}
+	if it.Prev() {
+		t.Error("iterator moved backward after a concurrent modification")
+	}
+
+	it = sl.Iterator()
+	i := 0
+	for it.Next() {
+		i++
+	}
+	if it.Err() != nil || i != len(items)-1 {
+		t.Errorf("fresh iterator: got %d items and err %v", i, it.Err())
+	}
+}
+
+func TestIterator_Seek(t *testing.T) {
+	items := []Pair[int, string]{
+		{-5, "beefcafe"},
+		{0, "foo"},
+		{2, "bar"},
+		{4, "bing"},
+		{8, "hello, world"},
+	}
+
+	sl := NewSkipList(items...)
+	it := sl.Iterator()
+
+	if it.Valid() {
+		t.Error("new iterator should not be valid")
+	}
+
+	tests := []struct {
+		seek    func(int) bool
+		key     int
+		wantOk  bool
+		wantKey int
+	}{
+		{it.Seek, 1, true, 2},
+		{it.Seek, 2, true, 2},
+		{it.Seek, -10, true, -5},
+		{it.Seek, 9, false, 0},
+		{it.SeekForPrev, 3, true, 2},
+		{it.SeekForPrev, 4, true, 4},
+		{it.SeekForPrev, 100, true, 8},
+		{it.SeekForPrev, -6, false, 0},
+	}
+	for _, test := range tests {
+		ok := test.seek(test.key)
+		if ok != test.wantOk || ok != it.Valid() {
+			t.Errorf("seek %d: expected %v, got %v (valid %v)", test.key, test.wantOk, ok, it.Valid())
+		}
+		if ok && it.Key() != test.wantKey {
+			t.Errorf("seek %d: expected key %d, got %d", test.key, test.wantKey, it.Key())
+		}
+	}
+
+	it.Seek(9)
+	if !it.Prev() || it.Key() != 8 {
+		t.Errorf("prev after seeking past the end: expected key 8, got %d", it.Key())
+	}
+	it.SeekForPrev(-6)
+	if !it.Next() || it.Key() != -5 {
+		t.Errorf("next after seeking before the start: expected key -5, got %d", it.Key())
+	}
+
+	if !it.SeekToFirst() || it.Key() != -5 {
+		t.Errorf("seek to first: expected key -5, got %d", it.Key())
+	}
+	if !it.SeekToLast() || it.Key() != 8 {
+		t.Errorf("seek to last: expected key 8, got %d", it.Key())
+	}
+
+	sl.Delete(8)
+	if it.Prev() || it.Err() != ErrConcurrentModification {
+		t.Errorf("expected %v after delete, got %v", ErrConcurrentModification, it.Err())
+	}
+	if !it.SeekToLast() || it.Key() != 4 || it.Err() != nil {
+		t.Errorf("seek after delete: expected key 4 and no error, got %d and %v", it.Key(), it.Err())
+	}
+
+	rng := sl.Range(0, 4)
+	if !rng.SeekToLast() || rng.Key() != 2 {
+		t.Errorf("range seek to last: expected key 2, got %d", rng.Key())
+	}
+	if rng.Seek(4) {
+		t.Errorf("range seek past the end: expected invalid, got key %d", rng.Key())
+	}
+	for _, key := range []int{4, 5, 100} {
+		if rng.Seek(key) || !rng.Prev() || rng.Key() != 2 {
+			t.Errorf("range prev after seeking to %d: expected key 2, got %d", key, rng.Key())
+		}
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		it.Seek(1)
+		it.SeekForPrev(3)
+		rng.Seek(-10)
+		rng.SeekForPrev(100)
+	})
+	if allocs != 0 {
+		t.Errorf("seek: expected no allocations, got %v", allocs)
+	}
+
+	empty := NewSkipList[int, string]()
+	it = empty.Iterator()
+	if it.SeekToFirst() || it.SeekToLast() || it.Seek(0) || it.SeekForPrev(0) || it.Prev() {
+		t.Error("seek on an empty list should not be valid")
+	}
+}
+
+func TestIterator_Remove(t *testing.T) {
+	items := []Pair[int, string]{
+		{-5, "beefcafe"},
+		{0, "foo"},
+		{1, "bar"},
+		{2, "barTwo"},
+		{4, "bing"},
+		{7, "bong"},
+		{8, "hello, world"},
+	}
+
+	sl := NewSkipList(items...)
+
+	it := sl.Iterator()
+	for it.Next() {
+		if it.Key()%2 == 0 {
+			it.Remove()
+		} else {
+			it.SetValue(it.Value() + "!")
+		}
+	}
+	if it.Err() != nil {
+		t.Errorf("remove while iterating: unexpected error %v", it.Err())
+	}
+
+	want := []Pair[int, string]{{-5, "beefcafe!"}, {1, "bar!"}, {7, "bong!"}}
+	var got []Pair[int, string]
+	for it = sl.Iterator(); it.Next(); {
+		got = append(got, Pair[int, string]{it.Key(), it.Value()})
+	}
+	if len(got) != len(want) || sl.Len() != len(want) {
+		t.Fatalf("remove while iterating: want %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("remove while iterating: want %v, got %v", want[i], got[i])
+		}
+	}
+
+	it.SeekToLast()
+	it.Remove()
+	if sl.Last().Key() != 1 {
+		t.Errorf("remove last: expected new last key 1, got %v", sl.Last().Key())
+	}
+	if !it.Prev() || it.Key() != 1 {
+		t.Errorf("prev after removing last: expected key 1, got %v", it.Key())
+	}
+	it.Remove()
+	if !it.Prev() || it.Key() != -5 {
+		t.Errorf("prev after remove: expected key -5, got %v", it.Key())
+	}
+	it.Remove()
+	if it.Prev() || it.Next() || !sl.IsEmpty() {
+		t.Errorf("remove all: expected an empty list, got %v", sl)
+	}
+}
+
+func TestIterator_RangePrev(t *testing.T) {
+	items := []Pair[int, string]{
+		{-5, "beefcafe"},
+		{0, "foo"},
+		{1, "bar"},
+		{2, "bar"},
+		{4, "bing"},
+		{7, "bong"},
+		{8, "hello, world"},
+	}
+
+	sl := NewSkipList(items...)
+
+	it := sl.Range(0, 7)
+	for it.Next() {
+	}
+	if it.Key() != 4 {
+		t.Errorf("range: expected last key 4, got %v", it.Key())
+	}
+	i := 4
+	for it.Prev() {
+		i--
+		if it.Key() != items[i].key {
+			t.Errorf("range prev: expected key %v, got %v", items[i].key, it.Key())
+		}
+	}
+	if i != 1 || it.Key() != 0 {
+		t.Errorf("range prev: moved below the start of the range to key %v", it.Key())
+	}
+}
+
+func TestIterator_ReverseRange(t *testing.T) {
+	items := []Pair[int, string]{
+		{-5, "beefcafe"},
+		{0, "foo"},
+		{1, "bar"},
+		{2, "bar"},
+		{4, "bing"},
+		{7, "bong"},
+		{8, "hello, world"},
+	}
+
+	sl := NewSkipList(items...)
+
+	it := sl.ReverseRange(6, 0)
+	i := 4
+	for it.Prev() {
+		key, val := it.Key(), it.Value()
+		if key != items[i].key {
+			t.Errorf("key mismatch, expected %v, got %v", items[i].key, key)
+		}
+		if val != items[i].val {
+			t.Errorf("val mismatch, expected %v, got %v", items[i].val, val)
+		}
+		i--
+	}
+	if i != 1 {
+		t.Errorf("reverse range: expected to stop above key 0, stopped at %v", it.Key())
+	}
+	for it.Next() {
+	}
+	if it.Key() != 4 {
+		t.Errorf("reverse range next: moved above the top of the range to key %v", it.Key())
+	}
+
+	if it = sl.ReverseRange(-6, -10); it != nil {
+		t.Error("reverse range below all keys: expected nil")
+	}
+	it = sl.ReverseRange(7, 2)
+	if !it.Prev() || it.Key() != 7 {
+		t.Errorf("reverse range: expected inclusive top key 7, got %v", it.Key())
+	}
+}
+
+func TestSkipList_Iter(t *testing.T) {
+	sl := NewSkipList[int, string]()
+	for i := 0; i < 10; i++ {
+		sl.Set(i, "")
+	}
+
+	tests := []struct {
+		bounds Bounds[int]
+		want   []int
+	}{
+		{Bounds[int]{}, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		{Bounds[int]{Inclusive(3), Exclusive(6)}, []int{3, 4, 5}},
+		{Bounds[int]{Exclusive(3), Inclusive(6)}, []int{4, 5, 6}},
+		{Bounds[int]{Exclusive(7), Unbounded[int]()}, []int{8, 9}},
+		{Bounds[int]{Unbounded[int](), Inclusive(1)}, []int{0, 1}},
+		{Bounds[int]{Inclusive(-5), Exclusive(0)}, nil},
+		{Bounds[int]{Inclusive(10), Unbounded[int]()}, nil},
+		{Bounds[int]{Exclusive(4), Exclusive(5)}, nil},
+	}
+	for _, test := range tests {
+		var got []int
+		it := sl.Iter(test.bounds)
+		for it.Next() {
+			got = append(got, it.Key())
+		}
+		if !slices.Equal(got, test.want) {
+			t.Errorf("iter %v: want %v, got %v", test.bounds, test.want, got)
+		}
+
+		got = nil
+		for ok := it.SeekToLast(); ok; ok = it.Prev() {
+			got = append(got, it.Key())
+		}
+		slices.Reverse(got)
+		if !slices.Equal(got, test.want) {
+			t.Errorf("iter %v backwards: want %v, got %v", test.bounds, test.want, got)
+		}
+	}
+}
@@ -37,6 +37,7 @@ type slNode[K, V any] struct {
 	isHeader bool
 	forward  []*slNode[K, V]
 	backward *slNode[K, V] // a pointer to the previous node only on the bottom level
+	agg      []V           // if the list has a monoid, the aggregate of the span each forward link skips
 }
 
 // Level return the highest level this node is in
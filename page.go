@@ -0,0 +1,141 @@
+package skiplist
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrInvalidCursor is returned by Page when a cursor can't be decoded into a key.
+var ErrInvalidCursor = errors.New("skiplist: invalid cursor")
+
+// ErrInvalidLimit is returned by Page when the page size is not positive.
+var ErrInvalidLimit = errors.New("skiplist: page limit must be positive")
+
+// Direction is the direction in which Page moves from its cursor.
+type Direction int
+
+const (
+	Forward  Direction = iota // the page after the cursor
+	Backward                  // the page before the cursor
+)
+
+// String returns the name of the direction.
+func (d Direction) String() string {
+	switch d {
+	case Forward:
+		return "Forward"
+	case Backward:
+		return "Backward"
+	}
+	return fmt.Sprintf("Direction(%d)", int(d))
+}
+
+// KeyCodec converts keys to and from the opaque cursor strings handed out by a Pager.
+type KeyCodec[K any] interface {
+	Encode(key K) (string, error)
+	Decode(cursor string) (K, error)
+}
+
+// JSONKeyCodec is a KeyCodec for any key type that round-trips through encoding/json, which
+// encodes keys as URL-safe base64 of their JSON encoding.
+type JSONKeyCodec[K any] struct{}
+
+// Encode returns the cursor for the key.
+func (JSONKeyCodec[K]) Encode(key K) (string, error) {
+	b, err := json.Marshal(key)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Decode returns the key for the cursor.
+func (JSONKeyCodec[K]) Decode(cursor string) (K, error) {
+	var key K
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return key, err
+	}
+	err = json.Unmarshal(b, &key)
+	return key, err
+}
+
+// Pager serves pages of a skip list by cursor, where a cursor is the encoded key at the boundary
+// of the previous page. Since a page is found by searching for the first key past the boundary,
+// rather than for the boundary itself, paging keeps working when that key has been deleted since
+// its cursor was handed out.
+type Pager[K, V any] struct {
+	sl    *SkipList[K, V]
+	codec KeyCodec[K]
+}
+
+// NewPager returns a Pager over the skip list that encodes cursors with the codec.
+func NewPager[K, V any](sl *SkipList[K, V], codec KeyCodec[K]) *Pager[K, V] {
+	return &Pager[K, V]{sl: sl, codec: codec}
+}
+
+// Page returns up to limit elements, in ascending order of key, from the page after the cursor
+// when dir is Forward, or from the page before it when dir is Backward. An empty cursor starts
+// from the first element going forward, or from the last element going backward. next is the
+// cursor for the following page and prev the cursor for the preceding one, each empty if there
+// are no elements in that direction. Returns ErrInvalidLimit if limit is not positive.
+// Time complexity: O(logN + limit), where N is the number of elements in the skip list.
+func (p *Pager[K, V]) Page(cursor string, limit int, dir Direction) (items []Pair[K, V], next, prev string, err error) {
+	if limit <= 0 {
+		return nil, "", "", ErrInvalidLimit
+	}
+	var bound Bound[K]
+	if cursor != "" {
+		key, err := p.codec.Decode(cursor)
+		if err != nil {
+			return nil, "", "", fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+		}
+		bound = Exclusive(key)
+	}
+
+	sl := p.sl
+	sl.rw.RLock()
+	defer sl.rw.RUnlock()
+
+	var before, after *slNode[K, V] // the nodes just outside the page, or nil if there are none
+	if dir == Forward {
+		x := sl.iterWithin(Bounds[K]{Lower: bound}).curr
+		if !x.isHeader {
+			before = x
+		}
+		for x = x.forward[0]; x != nil && len(items) < limit; x = x.forward[0] {
+			items = append(items, Pair[K, V]{x.key, x.val})
+		}
+		after = x
+	} else {
+		x := sl.iterWithin(Bounds[K]{Upper: bound}).last()
+		after = x.forward[0]
+		for ; !x.isHeader && len(items) < limit; x = x.backward {
+			items = append(items, Pair[K, V]{x.key, x.val})
+		}
+		if !x.isHeader {
+			before = x
+		}
+		slices.Reverse(items)
+	}
+
+	// the cursors are the keys of the first and last elements of the page, or the same cursor
+	// again if the page is empty but there are elements beyond it
+	if after != nil {
+		if next = cursor; len(items) > 0 {
+			next, err = p.codec.Encode(items[len(items)-1].key)
+		}
+	}
+	if before != nil && err == nil {
+		if prev = cursor; len(items) > 0 {
+			prev, err = p.codec.Encode(items[0].key)
+		}
+	}
+	if err != nil {
+		return nil, "", "", err
+	}
+	return items, next, prev, nil
+}
@@ -0,0 +1,64 @@
+package skiplist
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestPager_Page(t *testing.T) {
+	sl := NewSkipList[int, int]()
+	for i := 1; i <= 10; i++ {
+		sl.Set(i, i)
+	}
+	p := NewPager[int, int](sl, JSONKeyCodec[int]{})
+
+	page := func(cursor string, dir Direction) ([]int, string, string) {
+		items, next, prev, err := p.Page(cursor, 4, dir)
+		if err != nil {
+			t.Fatalf("page: %v", err)
+		}
+		var keys []int
+		for _, item := range items {
+			keys = append(keys, item.Key())
+		}
+		return keys, next, prev
+	}
+
+	got, next, prev := page("", Forward)
+	if want := []int{1, 2, 3, 4}; !slices.Equal(got, want) || prev != "" || next == "" {
+		t.Errorf("first page: want %v with only a next cursor, got %v, %q and %q", want, got, next, prev)
+	}
+	sl.Delete(4)
+	got, next, prev = page(next, Forward)
+	if want := []int{5, 6, 7, 8}; !slices.Equal(got, want) || prev == "" || next == "" {
+		t.Errorf("second page: want %v, got %v", want, got)
+	}
+	got, last, _ := page(next, Forward)
+	if want := []int{9, 10}; !slices.Equal(got, want) || last != "" {
+		t.Errorf("last page: want %v and no next cursor, got %v and %q", want, got, last)
+	}
+	sl.Delete(5)
+	got, _, prev = page(prev, Backward)
+	if want := []int{1, 2, 3}; !slices.Equal(got, want) || prev != "" {
+		t.Errorf("previous page: want %v and no prev cursor, got %v and %q", want, got, prev)
+	}
+	got, next, prev = page("", Backward)
+	if want := []int{7, 8, 9, 10}; !slices.Equal(got, want) || next != "" || prev == "" {
+		t.Errorf("page from end: want %v, got %v", want, got)
+	}
+
+	sl.DeleteAll(9, 10)
+	cursor, _ := JSONKeyCodec[int]{}.Encode(8)
+	got, _, prev = page(cursor, Forward)
+	if len(got) != 0 || prev != cursor {
+		t.Errorf("page past end: want no items and a prev cursor, got %v and %q", got, prev)
+	}
+
+	if _, _, _, err := p.Page("not a cursor!", 4, Forward); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("page: want ErrInvalidCursor, got %v", err)
+	}
+	if items, _, _, err := p.Page("", 0, Forward); err != ErrInvalidLimit || items != nil {
+		t.Errorf("page: want ErrInvalidLimit, got %v and %v", err, items)
+	}
+}
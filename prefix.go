@@ -0,0 +1,39 @@
+package skiplist
+
+// PrefixIterator returns a bidirectional iterator over the keys that start with prefix, stopping
+// at the first key that doesn't. The list must order its keys lexicographically by byte, as
+// NewSkipList does for strings, or NewCustomSkipList does with bytes.Compare for byte slices.
+// Like Iter, the iterator is never nil, and the first call to Next moves it onto the first key
+// with the prefix.
+func PrefixIterator[K ~string | ~[]byte, V any](sl *SkipList[K, V], prefix K) Iterator[K, V] {
+	return sl.iterWhere(func(key K) int {
+		if hasPrefix(key, prefix) {
+			return 0
+		}
+		return sl.compare(key, prefix)
+	})
+}
+
+// DeletePrefix removes every element with a key that starts with prefix, under a single lock, and
+// returns the number of elements removed. The list must order its keys lexicographically by byte.
+// Time complexity: O(logN + M), where M is the number of elements removed.
+func DeletePrefix[K ~string | ~[]byte, V any](sl *SkipList[K, V], prefix K) int {
+	sl.rw.Lock()
+	defer sl.rw.Unlock()
+
+	update, x := sl.searchNode(prefix)
+	x = x.forward[0]
+	n := 0
+	for x != nil && hasPrefix(x.key, prefix) {
+		next := x.forward[0]
+		sl.removeNode(update, x)
+		x = next
+		n++
+	}
+	return n
+}
+
+// hasPrefix returns true if key begins with prefix.
+func hasPrefix[K ~string | ~[]byte](key, prefix K) bool {
+	return len(key) >= len(prefix) && string(key[:len(prefix)]) == string(prefix)
+}
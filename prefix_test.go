@@ -0,0 +1,80 @@
+package skiplist
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+)
+
+func TestPrefixIterator(t *testing.T) {
+	sl := NewSkipList[string, int]()
+	for i, key := range []string{"tenant/1/a", "tenant/12/a", "tenant/123/a", "tenant/123/b", "tenant/124", "tenant/2", "zzz"} {
+		sl.Set(key, i)
+	}
+
+	tests := []struct {
+		prefix string
+		want   []string
+	}{
+		{"tenant/123/", []string{"tenant/123/a", "tenant/123/b"}},
+		{"tenant/12", []string{"tenant/12/a", "tenant/123/a", "tenant/123/b", "tenant/124"}},
+		{"tenant/3", nil},
+		{"a", nil},
+		{"zzzz", nil},
+	}
+	for _, test := range tests {
+		var got []string
+		it := PrefixIterator(sl, test.prefix)
+		for it.Next() {
+			got = append(got, it.Key())
+		}
+		if !slices.Equal(got, test.want) {
+			t.Errorf("prefix %q: want %v, got %v", test.prefix, test.want, got)
+		}
+
+		got = nil
+		for ok := it.SeekToLast(); ok; ok = it.Prev() {
+			got = append(got, it.Key())
+		}
+		slices.Reverse(got)
+		if !slices.Equal(got, test.want) {
+			t.Errorf("prefix %q backwards: want %v, got %v", test.prefix, test.want, got)
+		}
+	}
+
+	it := PrefixIterator(sl, "tenant/12")
+	for _, key := range []string{"tenant/125", "tenant/2", "zzzz"} {
+		if it.Seek(key) || !it.Prev() || it.Key() != "tenant/124" {
+			t.Errorf("prefix prev after seeking to %q: want tenant/124, got %q", key, it.Key())
+		}
+	}
+}
+
+func TestDeletePrefix(t *testing.T) {
+	sl := NewCustomSkipList[[]byte, int](func(bs1, bs2 []byte) bool {
+		return bytes.Compare(bs1, bs2) < 0
+	})
+	keys := [][]byte{{0x01}, {0x01, 0xff}, {0x01, 0xff, 0x00}, {0x01, 0xff, 0xff}, {0x02}, {0xff}}
+	for i, key := range keys {
+		sl.Set(key, i)
+	}
+
+	if n := DeletePrefix(sl, []byte{0x01, 0xff}); n != 3 {
+		t.Errorf("delete prefix: want 3 deleted, got %d", n)
+	}
+	if sl.Len() != 3 {
+		t.Errorf("delete prefix: want 3 left, got %d", sl.Len())
+	}
+	for _, i := range []int{0, 4, 5} {
+		if _, ok := sl.Get(keys[i]); !ok {
+			t.Errorf("delete prefix: key %v was deleted", keys[i])
+		}
+	}
+
+	if n := DeletePrefix(sl, []byte{0xff}); n != 1 || !bytes.Equal(sl.Last().Key(), []byte{0x02}) {
+		t.Errorf("delete prefix at the end: got %d deleted and last %v", n, sl.Last())
+	}
+	if n := DeletePrefix(sl, nil); n != 2 || !sl.IsEmpty() {
+		t.Errorf("delete empty prefix: got %d deleted and %d left", n, sl.Len())
+	}
+}
@@ -0,0 +1,84 @@
+package skiplist
+
+// Reversed is a view of a skip list in reverse order. It shares the list's elements, so changes
+// through either are visible in both. Its iterators run from the greatest key to the least, and
+// its ranges are given from high to low.
+type Reversed[K, V any] struct {
+	sl *SkipList[K, V]
+}
+
+// Reverse returns a view of the skip list in reverse order.
+func (sl *SkipList[K, V]) Reverse() Reversed[K, V] {
+	return Reversed[K, V]{sl}
+}
+
+// Reverse returns the underlying skip list, in its own order.
+func (r Reversed[K, V]) Reverse() *SkipList[K, V] {
+	return r.sl
+}
+
+// Len returns the number of elements in the skip list.
+func (r Reversed[K, V]) Len() int {
+	return r.sl.Len()
+}
+
+// Get returns the value associated with the key if the key exists and a bool indicating if it does.
+func (r Reversed[K, V]) Get(key K) (V, bool) {
+	return r.sl.Get(key)
+}
+
+// First returns the element with the maximum key, or nil if the list is empty.
+func (r Reversed[K, V]) First() *Pair[K, V] {
+	return r.sl.Last()
+}
+
+// Last returns the element with the minimum key, or nil if the list is empty.
+func (r Reversed[K, V]) Last() *Pair[K, V] {
+	return r.sl.First()
+}
+
+// Iterator returns a bidirectional iterator starting from the element with the maximum key, so
+// that Next moves towards lesser keys.
+func (r Reversed[K, V]) Iterator() Iterator[K, V] {
+	return &reverseIter[K, V]{r.sl.IteratorFromEnd()}
+}
+
+// Range returns a bidirectional iterator beginning at the last node with key less than or equal to
+// start (inclusive) to the node with key end (exclusive), where start is greater than end, or nil
+// if there is no node with key less than or equal to start.
+func (r Reversed[K, V]) Range(start, end K) Iterator[K, V] {
+	it := r.sl.ReverseRange(start, end)
+	if it == nil {
+		return nil
+	}
+	return &reverseIter[K, V]{it}
+}
+
+// Iter returns a bidirectional iterator over the keys within the bounds, where Lower is the
+// greater end, and Upper the lesser end, of the range. It is never nil.
+func (r Reversed[K, V]) Iter(bounds Bounds[K]) Iterator[K, V] {
+	r.sl.rw.RLock()
+	defer r.sl.rw.RUnlock()
+
+	it := r.sl.iterWithin(Bounds[K]{Lower: bounds.Upper, Upper: bounds.Lower})
+	it.curr = it.last()
+	return &reverseIter[K, V]{it}
+}
+
+// reverseIter is an iterator that runs another in the opposite direction.
+type reverseIter[K, V any] struct {
+	it Iterator[K, V]
+}
+
+func (r *reverseIter[K, V]) Next() bool             { return r.it.Prev() }
+func (r *reverseIter[K, V]) Prev() bool             { return r.it.Next() }
+func (r *reverseIter[K, V]) Key() K                 { return r.it.Key() }
+func (r *reverseIter[K, V]) Value() V               { return r.it.Value() }
+func (r *reverseIter[K, V]) Err() error             { return r.it.Err() }
+func (r *reverseIter[K, V]) Seek(key K) bool        { return r.it.SeekForPrev(key) }
+func (r *reverseIter[K, V]) SeekForPrev(key K) bool { return r.it.Seek(key) }
+func (r *reverseIter[K, V]) SeekToFirst() bool      { return r.it.SeekToLast() }
+func (r *reverseIter[K, V]) SeekToLast() bool       { return r.it.SeekToFirst() }
+func (r *reverseIter[K, V]) Valid() bool            { return r.it.Valid() }
+func (r *reverseIter[K, V]) SetValue(val V)         { r.it.SetValue(val) }
+func (r *reverseIter[K, V]) Remove()                { r.it.Remove() }
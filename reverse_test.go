@@ -0,0 +1,52 @@
+package skiplist
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSkipList_Reverse(t *testing.T) {
+	sl := NewSkipList[int, string]()
+	for i := 0; i < 10; i++ {
+		sl.Set(i, "")
+	}
+	r := sl.Reverse()
+
+	collect := func(it Iterator[int, string]) []int {
+		var res []int
+		for it.Next() {
+			res = append(res, it.Key())
+		}
+		return res
+	}
+
+	if got, want := collect(r.Iterator()), []int{9, 8, 7, 6, 5, 4, 3, 2, 1, 0}; !slices.Equal(got, want) {
+		t.Errorf("reverse iterator: want %v, got %v", want, got)
+	}
+	if got, want := collect(r.Range(7, 3)), []int{7, 6, 5, 4}; !slices.Equal(got, want) {
+		t.Errorf("reverse range: want %v, got %v", want, got)
+	}
+	if got, want := collect(r.Iter(Bounds[int]{Exclusive(7), Inclusive(3)})), []int{6, 5, 4, 3}; !slices.Equal(got, want) {
+		t.Errorf("reverse iter: want %v, got %v", want, got)
+	}
+	if got, want := collect(r.Iter(Bounds[int]{Unbounded[int](), Exclusive(7)})), []int{9, 8}; !slices.Equal(got, want) {
+		t.Errorf("reverse iter unbounded: want %v, got %v", want, got)
+	}
+	if r.First().Key() != 9 || r.Last().Key() != 0 || r.Len() != 10 || r.Reverse() != sl {
+		t.Errorf("reverse: wrong first %v, last %v or len %d", r.First(), r.Last(), r.Len())
+	}
+
+	it := r.Iterator()
+	if !it.Seek(5) || it.Key() != 5 || !it.Next() || it.Key() != 4 {
+		t.Errorf("reverse seek: want 5 then 4, got %v", it.Key())
+	}
+	if !it.Seek(20) || it.Key() != 9 {
+		t.Errorf("reverse seek past the start: want 9, got %v", it.Key())
+	}
+	if !it.SeekToLast() || it.Key() != 0 || !it.Prev() || it.Key() != 1 {
+		t.Errorf("reverse seek to last: want 0 then 1, got %v", it.Key())
+	}
+	if r.Range(-1, -5) != nil {
+		t.Error("reverse range below all keys: expected nil")
+	}
+}
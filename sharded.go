@@ -0,0 +1,375 @@
+package skiplist
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// rebalanceSkew is how many times the average size of the other shards a shard must grow to
+	// before a write to it rebalances the whole list. Measuring against the other shards, rather
+	// than against the average of all of them, lets a list with only two shards become skewed.
+	rebalanceSkew = 4
+	// rebalanceMinShard is the size below which a shard never triggers rebalancing, so that small
+	// lists aren't rebalanced over and over.
+	rebalanceMinShard = 1024
+)
+
+// ShardedSkipList partitions its keys by range across several inner skip lists, each with its own
+// lock, so writers to different shards don't contend with each other. Shard i holds the keys from
+// split point i-1 (inclusive) up to split point i (exclusive). Point operations go to a single
+// shard, and iterators traverse the shards in order, so the list as a whole keeps its global
+// ordering. When the keys skew towards one shard, the split points are recomputed by Rebalance,
+// which a write to a shard that has grown too large calls automatically.
+type ShardedSkipList[K, V any] struct {
+	mu      sync.RWMutex // held for reading by every operation, and for writing while rebalancing
+	compare func(K, K) int
+	splits  []K // the first key of every shard but the first
+	shards  []*SkipList[K, V]
+	gen     int          // the number of rebalances, used by iterators to detect them
+	size    atomic.Int64 // the total number of elements across the shards
+}
+
+// NewShardedSkipList initializes a sharded skip list using a cmp.Ordered key type, with one shard
+// more than the number of split points given. See SampleSplits for choosing split points from a
+// sample of keys.
+func NewShardedSkipList[K cmp.Ordered, V any](splits ...K) *ShardedSkipList[K, V] {
+	return NewShardedSkipListFunc[K, V](cmp.Compare[K], splits...)
+}
+
+// NewShardedSkipListFunc initializes a sharded skip list using a custom key type ordered by a
+// three-way comparison function, as for NewSkipListFunc, with one shard more than the number of
+// distinct split points given.
+func NewShardedSkipListFunc[K, V any](compare func(K, K) int, splits ...K) *ShardedSkipList[K, V] {
+	splits = slices.Clone(splits)
+	slices.SortFunc(splits, compare)
+	splits = slices.CompactFunc(splits, func(k1, k2 K) bool { return compare(k1, k2) == 0 })
+
+	s := &ShardedSkipList[K, V]{compare: compare, splits: splits}
+	s.shards = make([]*SkipList[K, V], len(splits)+1)
+	for i := range s.shards {
+		s.shards[i] = NewSkipListFunc[K, V](compare)
+	}
+	return s
+}
+
+// SampleSplits returns the n-1 split points that divide a sample of keys into n shards of roughly
+// equal size, for use with NewShardedSkipList. Fewer are returned if the sample doesn't have
+// enough distinct keys.
+func SampleSplits[K cmp.Ordered](sample []K, n int) []K {
+	return SampleSplitsFunc(sample, n, cmp.Compare[K])
+}
+
+// SampleSplitsFunc is SampleSplits for keys ordered by a three-way comparison function.
+func SampleSplitsFunc[K any](sample []K, n int, compare func(K, K) int) []K {
+	sample = slices.Clone(sample)
+	slices.SortFunc(sample, compare)
+	sample = slices.CompactFunc(sample, func(k1, k2 K) bool { return compare(k1, k2) == 0 })
+	return quantiles(sample, n)
+}
+
+// quantiles returns the keys at which sorted, distinct keys divide into n runs of roughly equal
+// length, or fewer if there are fewer than n keys.
+func quantiles[K any](keys []K, n int) []K {
+	n = min(n, len(keys))
+	var splits []K
+	for i := 1; i < n; i++ {
+		splits = append(splits, keys[i*len(keys)/n])
+	}
+	return splits
+}
+
+// Len returns the number of elements in the list.
+func (s *ShardedSkipList[K, V]) Len() int {
+	return int(s.size.Load())
+}
+
+// Shards returns the number of shards.
+func (s *ShardedSkipList[K, V]) Shards() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.shards)
+}
+
+// Get returns the value associated with the key if the key exists and a bool indicating if it does.
+// Time complexity: O(logN), where N is the number of elements in the key's shard.
+func (s *ShardedSkipList[K, V]) Get(key K) (V, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.shards[s.shardFor(key)].Get(key)
+}
+
+// Set inserts the key with the value, or updates the value if the key exists, locking only the
+// key's shard. Returns true if the key was newly inserted, or false and the old value if it was
+// updated. Time complexity: O(logN), where N is the number of elements in the key's shard.
+func (s *ShardedSkipList[K, V]) Set(key K, val V) (bool, V) {
+	s.mu.RLock()
+	shard := s.shards[s.shardFor(key)]
+	inserted, old := shard.Set(key, val)
+	if inserted {
+		s.size.Add(1)
+	}
+	skewed := inserted && s.skewed(shard)
+	s.mu.RUnlock()
+
+	if skewed {
+		s.rebalance(true)
+	}
+	return inserted, old
+}
+
+// Delete removes the key from the list, locking only the key's shard. Returns the value of the
+// deleted key if it existed and a bool indicating if it did. Time complexity: O(logN), where N is
+// the number of elements in the key's shard.
+func (s *ShardedSkipList[K, V]) Delete(key K) (V, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	val, ok := s.shards[s.shardFor(key)].Delete(key)
+	if ok {
+		s.size.Add(-1)
+	}
+	return val, ok
+}
+
+// Rebalance recomputes the split points so that every shard holds roughly the same number of
+// elements, and moves the elements into their new shards. It blocks every other operation while
+// it runs, and iterators created before it stop with ErrConcurrentModification.
+// Time complexity: O(NlogN), where N is the number of elements in the list.
+func (s *ShardedSkipList[K, V]) Rebalance() {
+	s.rebalance(false)
+}
+
+// rebalance redistributes the elements across the shards. If onlySkewed is set, it does nothing
+// unless some shard is still skewed once the write lock is held, since another writer may have
+// rebalanced in the meantime.
+func (s *ShardedSkipList[K, V]) rebalance(onlySkewed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if onlySkewed && !slices.ContainsFunc(s.shards, s.skewed) {
+		return
+	}
+
+	var items []Pair[K, V]
+	var keys []K
+	for _, shard := range s.shards {
+		for it := shard.Iterator(); it.Next(); {
+			items = append(items, Pair[K, V]{it.Key(), it.Value()})
+			keys = append(keys, it.Key())
+		}
+	}
+	if len(keys) < len(s.shards) {
+		return
+	}
+
+	s.splits = quantiles(keys, len(s.shards))
+	for i := range s.shards {
+		s.shards[i] = NewSkipListFunc[K, V](s.compare)
+	}
+	for _, item := range items {
+		s.shards[s.shardFor(item.key)].set(item.key, item.val)
+	}
+	s.gen++
+}
+
+// skewed returns true if the shard has grown large enough, relative to the average, to warrant
+// rebalancing. It must be called with s.mu held.
+func (s *ShardedSkipList[K, V]) skewed(shard *SkipList[K, V]) bool {
+	n := int64(shard.Len())
+	if n < rebalanceMinShard || len(s.shards) < 2 {
+		return false
+	}
+	return n > rebalanceSkew*(s.size.Load()-n)/int64(len(s.shards)-1)
+}
+
+// shardFor returns the index of the shard that holds the key. It must be called with s.mu held.
+func (s *ShardedSkipList[K, V]) shardFor(key K) int {
+	i, found := slices.BinarySearchFunc(s.splits, key, s.compare)
+	if found {
+		i++
+	}
+	return i
+}
+
+// Iterator returns a bidirectional iterator over every element, in order across the shards.
+func (s *ShardedSkipList[K, V]) Iterator() Iterator[K, V] {
+	return s.Iter(Bounds[K]{})
+}
+
+// Range returns a bidirectional iterator over the elements with keys greater than or equal to
+// start (inclusive) and less than end (exclusive), in order across the shards. It is never nil.
+func (s *ShardedSkipList[K, V]) Range(start, end K) Iterator[K, V] {
+	return s.Iter(Bounds[K]{Inclusive(start), Exclusive(end)})
+}
+
+// Iter returns a bidirectional iterator over the keys within the bounds, in order across the
+// shards. Each shard's part of the traversal is fail-fast as for a single list, and a Rebalance
+// stops the iterator with ErrConcurrentModification until it is seeked again.
+func (s *ShardedSkipList[K, V]) Iter(bounds Bounds[K]) Iterator[K, V] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	it := &shardedIter[K, V]{s: s, bounds: bounds}
+	it.reset()
+	if bounds.Lower.set {
+		it.shard = s.shardFor(bounds.Lower.key)
+	}
+	it.it = it.shards[it.shard].Iter(bounds)
+	return it
+}
+
+// shardedIter iterates over the shards of a ShardedSkipList in turn, with an iterator over the
+// current shard. Since the shards hold disjoint ranges of keys, each shard's iterator is given the
+// bounds of the whole traversal. When a move runs off the end of a shard, the iterator looks for
+// the next shard in that direction with an element in range, and stays where it was if there is
+// none, like an iterator over a single list.
+type shardedIter[K, V any] struct {
+	s      *ShardedSkipList[K, V]
+	bounds Bounds[K]
+	shards []*SkipList[K, V] // the shards when the iterator was created or last seeked
+	gen    int               // the list's gen at the same time
+	shard  int               // the index of the current shard
+	it     Iterator[K, V]    // the iterator over the current shard
+	err    error
+}
+
+// checkGen records ErrConcurrentModification and returns false if the list has been rebalanced
+// since the iterator was created. It must be called with s.mu held.
+func (it *shardedIter[K, V]) checkGen() bool {
+	if it.err == nil && it.s.gen != it.gen {
+		it.err = ErrConcurrentModification
+	}
+	return it.err == nil
+}
+
+// reset takes a fresh view of the shards before the iterator is repositioned. It must be called
+// with s.mu held.
+func (it *shardedIter[K, V]) reset() {
+	it.shards, it.gen, it.err = it.s.shards, it.s.gen, nil
+}
+
+// seekFrom positions the iterator with seek on shard i, or failing that with next on each of the
+// following shards in direction dir, and returns true if it found an element. If there is none,
+// the iterator is left as seek left it.
+func (it *shardedIter[K, V]) seekFrom(i, dir int, seek func(Iterator[K, V]) bool, next func(Iterator[K, V]) bool) bool {
+	it.shard, it.it = i, it.shards[i].Iter(it.bounds)
+	if seek(it.it) {
+		return true
+	}
+	return it.advance(dir, next)
+}
+
+// advance moves the iterator onto an element of the next shard in direction dir that has one in
+// range, using move on a fresh iterator over the shard, and returns true if there is one.
+func (it *shardedIter[K, V]) advance(dir int, move func(Iterator[K, V]) bool) bool {
+	if it.it.Err() != nil {
+		return false
+	}
+	for i := it.shard + dir; i >= 0 && i < len(it.shards); i += dir {
+		next := it.shards[i].Iter(it.bounds)
+		if move(next) {
+			it.shard, it.it = i, next
+			return true
+		}
+	}
+	return false
+}
+
+func (it *shardedIter[K, V]) Next() bool {
+	it.s.mu.RLock()
+	defer it.s.mu.RUnlock()
+
+	if !it.checkGen() {
+		return false
+	}
+	return it.it.Next() || it.advance(1, Iterator[K, V].SeekToFirst)
+}
+
+func (it *shardedIter[K, V]) Prev() bool {
+	it.s.mu.RLock()
+	defer it.s.mu.RUnlock()
+
+	if !it.checkGen() {
+		return false
+	}
+	return it.it.Prev() || it.advance(-1, Iterator[K, V].SeekToLast)
+}
+
+func (it *shardedIter[K, V]) Key() K {
+	return it.it.Key()
+}
+
+func (it *shardedIter[K, V]) Value() V {
+	return it.it.Value()
+}
+
+func (it *shardedIter[K, V]) Err() error {
+	if it.err != nil {
+		return it.err
+	}
+	return it.it.Err()
+}
+
+func (it *shardedIter[K, V]) Seek(key K) bool {
+	it.s.mu.RLock()
+	defer it.s.mu.RUnlock()
+
+	it.reset()
+	seek := func(shard Iterator[K, V]) bool { return shard.Seek(key) }
+	return it.seekFrom(it.s.shardFor(key), 1, seek, Iterator[K, V].SeekToFirst)
+}
+
+func (it *shardedIter[K, V]) SeekForPrev(key K) bool {
+	it.s.mu.RLock()
+	defer it.s.mu.RUnlock()
+
+	it.reset()
+	seek := func(shard Iterator[K, V]) bool { return shard.SeekForPrev(key) }
+	return it.seekFrom(it.s.shardFor(key), -1, seek, Iterator[K, V].SeekToLast)
+}
+
+func (it *shardedIter[K, V]) SeekToFirst() bool {
+	it.s.mu.RLock()
+	defer it.s.mu.RUnlock()
+
+	it.reset()
+	return it.seekFrom(0, 1, Iterator[K, V].SeekToFirst, Iterator[K, V].SeekToFirst)
+}
+
+func (it *shardedIter[K, V]) SeekToLast() bool {
+	it.s.mu.RLock()
+	defer it.s.mu.RUnlock()
+
+	it.reset()
+	return it.seekFrom(len(it.shards)-1, -1, Iterator[K, V].SeekToLast, Iterator[K, V].SeekToLast)
+}
+
+func (it *shardedIter[K, V]) Valid() bool {
+	return it.err == nil && it.it.Valid()
+}
+
+func (it *shardedIter[K, V]) SetValue(val V) {
+	it.s.mu.RLock()
+	defer it.s.mu.RUnlock()
+
+	if it.checkGen() {
+		it.it.SetValue(val)
+	}
+}
+
+func (it *shardedIter[K, V]) Remove() {
+	it.s.mu.RLock()
+	defer it.s.mu.RUnlock()
+
+	if !it.checkGen() || !it.it.Valid() {
+		return
+	}
+	if it.it.Remove(); !it.it.Valid() {
+		it.s.size.Add(-1)
+	}
+}
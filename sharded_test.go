@@ -0,0 +1,122 @@
+package skiplist
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestShardedSkipList(t *testing.T) {
+	s := NewShardedSkipList[int, int](30, 10, 20, 20)
+	if s.Shards() != 4 {
+		t.Fatalf("sharded: want 4 shards, got %d", s.Shards())
+	}
+	for i := 0; i < 40; i += 2 {
+		s.Set(i, i*10)
+	}
+	s.Set(4, 0)
+	s.Delete(20)
+	s.Delete(21)
+
+	collect := func(it Iterator[int, int]) []int {
+		var res []int
+		for it.Next() {
+			res = append(res, it.Key())
+		}
+		return res
+	}
+
+	want := []int{0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 22, 24, 26, 28, 30, 32, 34, 36, 38}
+	if got := collect(s.Iterator()); !slices.Equal(got, want) {
+		t.Errorf("sharded iterator: want %v, got %v", want, got)
+	}
+	if got, want := collect(s.Range(15, 31)), []int{16, 18, 22, 24, 26, 28, 30}; !slices.Equal(got, want) {
+		t.Errorf("sharded range: want %v, got %v", want, got)
+	}
+	if s.Len() != len(want) {
+		t.Errorf("sharded len: want %d, got %d", len(want), s.Len())
+	}
+	if v, ok := s.Get(4); !ok || v != 0 {
+		t.Errorf("sharded get: want 0, got %d", v)
+	}
+
+	it := s.Range(15, 31)
+	if !it.Seek(19) || it.Key() != 22 || !it.Prev() || it.Key() != 18 || !it.Prev() || it.Key() != 16 || it.Prev() {
+		t.Errorf("sharded seek and prev across shards")
+	}
+	if !it.SeekToLast() || it.Key() != 30 || it.Next() || !it.SeekForPrev(21) || it.Key() != 18 {
+		t.Errorf("sharded seek to last and seek for prev")
+	}
+	if !it.SeekToFirst() || it.Key() != 16 {
+		t.Errorf("sharded seek to first: want 16, got %d", it.Key())
+	}
+	it.Remove()
+	if !it.Next() || it.Key() != 18 || s.Len() != len(want)-1 {
+		t.Errorf("sharded remove: want next 18 and len %d, got %d and %d", len(want)-1, it.Key(), s.Len())
+	}
+
+	s.Rebalance()
+	if it.Next() || it.Err() != ErrConcurrentModification {
+		t.Errorf("sharded rebalance: want ErrConcurrentModification, got %v", it.Err())
+	}
+	if !it.Seek(22) || it.Key() != 22 || it.Err() != nil {
+		t.Errorf("sharded seek after rebalance: want 22, got %d", it.Key())
+	}
+}
+
+func TestShardedSkipList_Rebalance(t *testing.T) {
+	s := NewShardedSkipList[int, int](SampleSplits([]int{100, 200, 300, 400, 500, 600, 700, 800}, 4)...)
+	if want := []int{300, 500, 700}; !slices.Equal(s.splits, want) {
+		t.Errorf("sample splits: want %v, got %v", want, s.splits)
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < 20000; i += 4 {
+				s.Set(i, i)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	if s.Len() != 20000 {
+		t.Errorf("sharded rebalance: want len 20000, got %d", s.Len())
+	}
+	checkBalanced(t, s)
+	n := 0
+	for it := s.Iterator(); it.Next(); n++ {
+		if it.Key() != n {
+			t.Fatalf("sharded rebalance: want key %d, got %d", n, it.Key())
+		}
+	}
+}
+
+func TestShardedSkipList_RebalanceTwoShards(t *testing.T) {
+	s := NewShardedSkipList[int, int](100)
+	for i := 0; i < 20000; i++ {
+		s.Set(i, i)
+	}
+	checkBalanced(t, s)
+}
+
+// checkBalanced checks that the list was rebalanced automatically, and that no shard holds more
+// than rebalanceSkew times the average of the others.
+func checkBalanced(t *testing.T, s *ShardedSkipList[int, int]) {
+	t.Helper()
+
+	if s.gen == 0 {
+		t.Errorf("sharded rebalance: want an automatic rebalance, got none")
+	}
+	var sizes []int
+	for _, shard := range s.shards {
+		sizes = append(sizes, shard.Len())
+	}
+	for i, n := range sizes {
+		if others := s.Len() - n; n > rebalanceSkew*others/(len(sizes)-1) {
+			t.Errorf("sharded rebalance: shard %d is skewed, sizes %v", i, sizes)
+		}
+	}
+}
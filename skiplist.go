@@ -14,12 +14,19 @@ const AbsoluteMaxLevel = 64
 
 type SkipList[K, V any] struct {
 	rw       sync.RWMutex
-	maxLevel int             // the maximum number of levels a node can appear on
-	level    int             // the current highest level
-	size     int             // the current number of elements
-	lessThan func(K, K) bool // function used to compare keys
-	header   *slNode[K, V]   // the header node
-	max      *slNode[K, V]   // the node with the maximum key, which can also be considered the "end" or "back" of the list
+	maxLevel int              // the maximum number of levels a node can appear on
+	level    int              // the current highest level
+	size     int              // the current number of elements
+	compare  func(K, K) int   // function used to compare keys, returning <0, 0 or >0 like cmp.Compare
+	less     func(K, K) bool  // compare(a, b) < 0, used by searches so that they make a single call per node
+	header   *slNode[K, V]    // the header node
+	max      *slNode[K, V]    // the node with the maximum key, which can also be considered the "end" or "back" of the list
+	watchers []*watcher[K, V] // subscribers to change notifications, see Watch
+	modCount int              // the number of structural modifications, used by fail-fast iterators
+	monoid   *Monoid[V]       // if set, every link stores the aggregate of the span it skips, see SetMonoid
+	txns     []int            // the write log positions at which the open transactions began, in ascending order
+	writeLog []txnLogEntry[K] // the keys written since the oldest open transaction began
+	logBase  int              // the number of entries trimmed from the front of writeLog
 }
 
 // NewSkipList initializes a skip list using a cmp.Ordered key type and with a default max level of 32.
@@ -30,7 +37,8 @@ func NewSkipList[K cmp.Ordered, V any](items ...Pair[K, V]) *SkipList[K, V] {
 		level:    0,
 		size:     0,
 		header:   newHeader[K, V](DefaultMaxLevel),
-		lessThan: func(k1, k2 K) bool { return cmp.Compare[K](k1, k2) == -1 },
+		compare:  cmp.Compare[K],
+		less:     cmp.Less[K],
 	}
 	if items != nil && len(items) > 0 {
 		sl.SetAll(items)
@@ -41,14 +49,16 @@ func NewSkipList[K cmp.Ordered, V any](items ...Pair[K, V]) *SkipList[K, V] {
 // NewCustomSkipList initializes a skip list using a custom key type, which means there must be
 // a function that defines a linear ordering of keys, i.e. for two keys X & Y the function must
 // define how X is less than Y. Optionally include items with which to initialize the list. Uses
-// default max level of 32.
+// default max level of 32. Finding a key calls lessThan once per node on the search path, plus
+// once to check the key found for equality.
 func NewCustomSkipList[K, V any](lessThan func(K, K) bool, items ...Pair[K, V]) *SkipList[K, V] {
 	sl := &SkipList[K, V]{
 		maxLevel: DefaultMaxLevel - 1,
 		level:    0,
 		size:     0,
 		header:   newHeader[K, V](DefaultMaxLevel),
-		lessThan: lessThan,
+		compare:  lessCompare(lessThan),
+		less:     lessThan,
 	}
 	if items != nil && len(items) > 0 {
 		sl.SetAll(items)
@@ -56,6 +66,67 @@ func NewCustomSkipList[K, V any](lessThan func(K, K) bool, items ...Pair[K, V])
 	return sl
 }
 
+// NewSkipListFunc initializes a skip list using a custom key type ordered by a three-way
+// comparison function, which must return a negative number when X is less than Y, zero when they
+// are equal, and a positive number when X is greater than Y, like cmp.Compare or bytes.Compare.
+// Finding a key calls compare once per node on the search path, plus once to check the key found
+// for equality. Optionally include items with which to initialize the list. Uses default max
+// level of 32.
+func NewSkipListFunc[K, V any](compare func(K, K) int, items ...Pair[K, V]) *SkipList[K, V] {
+	sl := &SkipList[K, V]{
+		maxLevel: DefaultMaxLevel - 1,
+		level:    0,
+		size:     0,
+		header:   newHeader[K, V](DefaultMaxLevel),
+		compare:  compare,
+		less:     func(k1, k2 K) bool { return compare(k1, k2) < 0 },
+	}
+	if items != nil && len(items) > 0 {
+		sl.SetAll(items)
+	}
+	return sl
+}
+
+// Comparer is implemented by key types that order themselves with a Compare method, which returns
+// a negative number, zero or a positive number when the receiver is less than, equal to or greater
+// than the argument, like time.Time and netip.Addr.
+type Comparer[K any] interface {
+	Compare(K) int
+}
+
+// Lesser is implemented by key types that order themselves with a Less method, which returns true
+// when the receiver is less than the argument.
+type Lesser[K any] interface {
+	Less(K) bool
+}
+
+// NewComparerSkipList initializes a skip list for a key type with a Compare method, so that no
+// comparison function needs to be passed around. Optionally include items with which to
+// initialize the list. Uses default max level of 32.
+func NewComparerSkipList[K Comparer[K], V any](items ...Pair[K, V]) *SkipList[K, V] {
+	return NewSkipListFunc(K.Compare, items...)
+}
+
+// NewLesserSkipList initializes a skip list for a key type with a Less method, so that no
+// comparison function needs to be passed around. Optionally include items with which to
+// initialize the list. Uses default max level of 32.
+func NewLesserSkipList[K Lesser[K], V any](items ...Pair[K, V]) *SkipList[K, V] {
+	return NewCustomSkipList(K.Less, items...)
+}
+
+// lessCompare adapts a less-than function to a three-way comparison function.
+func lessCompare[K any](lessThan func(K, K) bool) func(K, K) int {
+	return func(k1, k2 K) int {
+		if lessThan(k1, k2) {
+			return -1
+		}
+		if lessThan(k2, k1) {
+			return 1
+		}
+		return 0
+	}
+}
+
 // Len returns the number of elements in the skip list.
 func (sl *SkipList[K, V]) Len() int {
 	sl.rw.RLock()
@@ -122,6 +193,9 @@ func (sl *SkipList[K, V]) SetMaxLevel(newMaxLevel int) {
 	}
 	for i := sl.maxLevel + 1; i < newMaxLevel; i++ {
 		sl.header.forward = append(sl.header.forward, nil)
+		if sl.monoid != nil {
+			sl.header.agg = append(sl.header.agg, sl.monoid.Identity)
+		}
 	}
 	sl.maxLevel = newMaxLevel
 
@@ -132,49 +206,22 @@ func (sl *SkipList[K, V]) SetMaxLevel(newMaxLevel int) {
 // this updated an existing key, returns the old value and false.
 // Time complexity: O(logN), where N is the number of elements in the skip list.
 func (sl *SkipList[K, V]) Set(key K, val V) (bool, V) {
-	sl.rw.RLock()
-	update, x := sl.searchNode(key)
-	x = x.forward[0]
-	sl.rw.RUnlock()
-
 	sl.rw.Lock()
 	defer sl.rw.Unlock()
 
-	var oldVal V
-	if x != nil && !sl.lessThan(key, x.key) {
-		oldVal = x.val
-		x.val = val
-		return false, oldVal
-	}
-
-	lvl := sl.randomLevel()
-	if lvl > sl.level {
-		for i := sl.level + 1; i <= lvl; i++ {
-			update[i] = sl.header
-		}
-		sl.level = lvl
-	}
-
-	x = newNode[K](lvl, key, val)
-	for i := 0; i <= lvl; i++ {
-		x.forward[i] = update[i].forward[i]
-		update[i].forward[i] = x
-	}
-	x.backward = update[0]
-	if x.forward[0] != nil {
-		x.forward[0].backward = x
-	}
-	if sl.max == nil || sl.lessThan(sl.max.key, x.key) {
-		sl.max = x
-	}
-
-	sl.size++
-	return true, oldVal
+	return sl.set(key, val)
 }
 
-// SetAll inserts each key-value pair in an array of pairs into the skip list.
+// SetAll inserts each key-value pair in an array of pairs into the skip list. If the list is empty
+// and the pairs are sorted by key with no duplicates, as when initializing a list, the nodes are
+// linked in one pass without searching. Time complexity: O(MlogN) in general, where M is the number
+// of pairs and N is the number of elements of the skip list, or O(M) for sorted pairs.
 func (sl *SkipList[K, V]) SetAll(items []Pair[K, V]) {
 	sl.rw.Lock()
+	if sl.size == 0 && sl.sorted(items) {
+		sl.setSorted(items)
+		items = nil
+	}
 	for _, item := range items {
 		sl.set(item.key, item.val)
 	}
@@ -185,40 +232,10 @@ func (sl *SkipList[K, V]) SetAll(items []Pair[K, V]) {
 // existed and a bool indicating if it did. Time complexity: O(logN), where N is the number of
 // elements in the skip list.
 func (sl *SkipList[K, V]) Delete(key K) (V, bool) {
-	sl.rw.RLock()
-	update, x := sl.searchNode(key)
-	x = x.forward[0]
-	sl.rw.RUnlock()
-
 	sl.rw.Lock()
 	defer sl.rw.Unlock()
 
-	var val V
-	if x != nil && !sl.lessThan(key, x.key) {
-		if x.forward[0] == nil {
-			sl.max = update[0]
-		}
-		if sl.max.isHeader {
-			sl.max = nil
-		}
-		for i := 0; i <= sl.level; i++ {
-			if update[i].forward[i] != x {
-				break
-			}
-			update[i].forward[i] = x.forward[i]
-		}
-		if x.forward[0] != nil {
-			x.forward[0].backward = update[0]
-		}
-		val = x.val
-		x = nil
-		sl.size--
-		for i := sl.level; i > 0 && sl.header.forward[sl.level] == nil; i-- {
-			sl.level -= 1
-		}
-		return val, true
-	}
-	return val, false
+	return sl.delete(key)
 }
 
 // DeleteAll elements with the given keys. Time complexity: O(MlogN), where M
@@ -240,55 +257,189 @@ func (sl *SkipList[K, V]) Get(key K) (V, bool) {
 	_, x := sl.searchNode(key)
 	x = x.forward[0]
 	var val V
-	if x != nil && !sl.lessThan(key, x.key) {
+	if x != nil && !sl.less(key, x.key) {
 		val = x.val
 		return val, true
 	}
 	return val, false
 }
 
+// SetIfAbsent sets key to val only if the key isn't in the skip list. Returns the existing value
+// and true if it was, or val and false if val was inserted. The check and the insertion are done
+// under a single lock. Time complexity: O(logN), where N is the number of elements in the skip list.
+func (sl *SkipList[K, V]) SetIfAbsent(key K, val V) (actual V, loaded bool) {
+	sl.rw.Lock()
+	defer sl.rw.Unlock()
+
+	update, x := sl.searchNode(key)
+	x = x.forward[0]
+	if x != nil && !sl.less(key, x.key) {
+		return x.val, true
+	}
+	sl.insertNode(update, key, val)
+	return val, false
+}
+
+// Swap sets key to val and returns the previous value and true if the key existed, or the zero
+// value and false if it didn't. Time complexity: O(logN).
+func (sl *SkipList[K, V]) Swap(key K, val V) (previous V, loaded bool) {
+	sl.rw.Lock()
+	defer sl.rw.Unlock()
+
+	inserted, previous := sl.set(key, val)
+	return previous, !inserted
+}
+
+// CompareAndSwap sets key to new only if the key exists and its value is equal to old, and
+// returns true if it did. As with sync.Map, the values are compared with ==, so V must hold a
+// comparable type or CompareAndSwap panics. Time complexity: O(logN).
+func (sl *SkipList[K, V]) CompareAndSwap(key K, old, new V) (swapped bool) {
+	sl.rw.Lock()
+	defer sl.rw.Unlock()
+
+	update, x := sl.searchNode(key)
+	x = x.forward[0]
+	if x == nil || sl.less(key, x.key) || any(x.val) != any(old) {
+		return false
+	}
+	sl.updateNode(update, x, new)
+	return true
+}
+
+// CompareAndDelete removes key only if it exists and its value is equal to old, and returns true
+// if it did. As with CompareAndSwap, V must hold a comparable type. Time complexity: O(logN).
+func (sl *SkipList[K, V]) CompareAndDelete(key K, old V) (deleted bool) {
+	sl.rw.Lock()
+	defer sl.rw.Unlock()
+
+	update, x := sl.searchNode(key)
+	x = x.forward[0]
+	if x == nil || sl.less(key, x.key) || any(x.val) != any(old) {
+		return false
+	}
+	sl.removeNode(update, x)
+	return true
+}
+
+// Op is the action a Compute callback asks for.
+type Op int
+
+const (
+	OpKeep   Op = iota // leave the list unchanged
+	OpSet              // insert the key, or update it if it exists, with the returned value
+	OpDelete           // delete the key if it exists
+)
+
+// Compute calls fn with the current value of key and whether it exists, then inserts, updates or
+// deletes the key, or leaves it as is, according to the Op fn returns. The search, the callback
+// and the change all happen under a single write lock, so fn must not call back into the list.
+// Returns the value of the key afterwards and whether it exists.
+// Time complexity: O(logN), where N is the number of elements in the skip list.
+func (sl *SkipList[K, V]) Compute(key K, fn func(old V, exists bool) (new V, op Op)) (V, bool) {
+	sl.rw.Lock()
+	defer sl.rw.Unlock()
+
+	update, x := sl.searchNode(key)
+	x = x.forward[0]
+	exists := x != nil && !sl.less(key, x.key)
+
+	var old V
+	if exists {
+		old = x.val
+	}
+	val, op := fn(old, exists)
+
+	switch {
+	case op == OpSet && exists:
+		sl.updateNode(update, x, val)
+		return val, true
+	case op == OpSet:
+		sl.insertNode(update, key, val)
+		return val, true
+	case op == OpDelete && exists:
+		sl.removeNode(update, x)
+		var zero V
+		return zero, false
+	}
+	return old, exists
+}
+
 // Range returns a bidirectional iterator beginning at the first node with key greater than or
-// equal to start (inclusive) to the node with key end (exclusive), or nil if the list is empty.
+// equal to start (inclusive) to the node with key end (exclusive), or nil if there is no node
+// with key greater than or equal to start. The iterator stays within [start, end) in both
+// directions, so Prev never moves below start.
 func (sl *SkipList[K, V]) Range(start, end K) Iterator[K, V] {
 	sl.rw.RLock()
 	defer sl.rw.RUnlock()
 
 	update, startNode := sl.searchNode(start)
 	startNode = startNode.forward[0]
-	if startNode != nil && !sl.lessThan(startNode.key, start) {
-		return sl.iterator(update[0], &end)
+	if startNode != nil && !sl.less(startNode.key, start) {
+		return sl.iterator(update[0], Inclusive(start), Exclusive(end))
 	}
 	return nil
 }
 
+// ReverseRange returns a bidirectional iterator for walking backwards from the last node with key
+// less than or equal to hi (inclusive) to the node with key lo (exclusive), or nil if there is no
+// node with key less than or equal to hi. It is the mirror image of Range: the first call to Prev
+// moves it onto the top of the range, and it stays within (lo, hi] in both directions.
+func (sl *SkipList[K, V]) ReverseRange(hi, lo K) Iterator[K, V] {
+	sl.rw.RLock()
+	defer sl.rw.RUnlock()
+
+	_, x := sl.searchNode(hi)
+	if next := x.forward[0]; next != nil && !sl.less(hi, next.key) {
+		x = next
+	}
+	if x.isHeader {
+		return nil
+	}
+	return sl.iterator(x, Exclusive(lo), Inclusive(hi))
+}
+
+// Iter returns a bidirectional iterator over the keys within the bounds. Unlike Range it is never
+// nil: if no keys are within the bounds, Next and Prev simply return false. The first call to Next
+// moves it onto the first key within the bounds; use SeekToLast to start from the last one.
+func (sl *SkipList[K, V]) Iter(bounds Bounds[K]) Iterator[K, V] {
+	sl.rw.RLock()
+	defer sl.rw.RUnlock()
+
+	return sl.iterWithin(bounds)
+}
+
 // Iterator returns a bidirectional iterator starting from the first node of the skip list,
 // or nil if the list is empty.
 func (sl *SkipList[K, V]) Iterator() Iterator[K, V] {
-	return sl.iterator(sl.header, nil)
+	sl.rw.RLock()
+	defer sl.rw.RUnlock()
+
+	return sl.iterator(sl.header, Unbounded[K](), Unbounded[K]())
 }
 
-// IteratorFromEnd returns a bidirectional iterator starting from the last node of the skip list, or nil if
-// the list is empty.
+// IteratorFromEnd returns a bidirectional iterator starting from the last node of the skip list.
+// The first call to Prev moves it onto the last node.
 func (sl *SkipList[K, V]) IteratorFromEnd() Iterator[K, V] {
-	var k K
-	var v V
-	dummy := newNode(1, k, v)
 	sl.rw.RLock()
-	dummy.backward = sl.max
-	sl.rw.RUnlock()
-	return sl.iterator(dummy, nil)
+	defer sl.rw.RUnlock()
+
+	if sl.max == nil {
+		return sl.iterator(sl.header, Unbounded[K](), Unbounded[K]())
+	}
+	return sl.iterator(sl.max, Unbounded[K](), Unbounded[K]())
 }
 
 // IteratorFrom returns a bidirectional iterator starting from the first node with key equal to
-// or greater than start, or nil if the list is empty.
+// or greater than start, or nil if there is no such node. To reposition an existing iterator
+// instead, use its Seek method.
 func (sl *SkipList[K, V]) IteratorFrom(start K) Iterator[K, V] {
 	sl.rw.RLock()
 	defer sl.rw.RUnlock()
 
 	update, startNode := sl.searchNode(start)
 	startNode = startNode.forward[0]
-	if startNode != nil && !sl.lessThan(startNode.key, start) {
-		return sl.iterator(update[0], nil)
+	if startNode != nil && !sl.less(startNode.key, start) {
+		return sl.iterator(update[0], Unbounded[K](), Unbounded[K]())
 	}
 	return nil
 }
@@ -297,10 +448,22 @@ func (sl *SkipList[K, V]) IteratorFrom(start K) Iterator[K, V] {
 func (sl *SkipList[K, V]) Clear() {
 	sl.rw.Lock()
 
+	if len(sl.watchers) > 0 {
+		for x := sl.header.forward[0]; x != nil; x = x.forward[0] {
+			sl.publish(Event[K, V]{Type: EventDelete, Key: x.key, Value: x.val})
+		}
+	}
+	if len(sl.txns) > 0 {
+		sl.writeLog = append(sl.writeLog, txnLogEntry[K]{all: true})
+	}
 	sl.size = 0
 	sl.level = 0
 	sl.max = nil
+	sl.modCount++
 	sl.header = newHeader[K, V](sl.maxLevel)
+	if sl.monoid != nil {
+		sl.rebuildAggregates()
+	}
 
 	sl.rw.Unlock()
 }
@@ -354,7 +517,8 @@ func (sl *SkipList[K, V]) String() string {
 
 // Merge returns a new skip list with the elements from both lists. For any keys that are
 // in both of the lists, the result will use the value from the second list.
-// The maxLevel of the result will be the greater maxLevel of the inputs.
+// The maxLevel of the result will be the greater maxLevel of the inputs. Neither input is
+// modified, so Merge publishes no events to their watchers, and the result starts with none.
 func Merge[K, V any](sl1, sl2 *SkipList[K, V]) *SkipList[K, V] {
 	sl1.rw.Lock()
 	sl2.rw.Lock()
@@ -364,11 +528,6 @@ func Merge[K, V any](sl1, sl2 *SkipList[K, V]) *SkipList[K, V] {
 		newMaxLevel = sl2.maxLevel
 	}
 
-	newMax := sl1.max
-	if sl1.lessThan(sl1.max.key, sl2.max.key) {
-		newMax = sl2.max
-	}
-
 	p1, p2 := sl1.header.forward[0], sl2.header.forward[0]
 
 	newHead := newHeader[K, V](newMaxLevel)
@@ -383,16 +542,13 @@ func Merge[K, V any](sl1, sl2 *SkipList[K, V]) *SkipList[K, V] {
 		k1, k2 := p1.key, p2.key
 
 		level := randomLevel(newMaxLevel)
-		if level > newLevel {
-			newLevel = level
-		}
 
 		var node *slNode[K, V]
-		if sl1.lessThan(k1, k2) {
+		if c := sl1.compare(k1, k2); c < 0 {
 			newSize++
 			node = newNode[K, V](level, k1, p1.val)
 			p1 = p1.forward[0]
-		} else if sl1.lessThan(k2, k1) {
+		} else if c > 0 {
 			newSize++
 			node = newNode[K, V](level, k2, p2.val)
 			p2 = p2.forward[0]
@@ -400,7 +556,9 @@ func Merge[K, V any](sl1, sl2 *SkipList[K, V]) *SkipList[K, V] {
 			p1 = p1.forward[0]
 			continue
 		}
+		newLevel = max(newLevel, level)
 
+		node.backward = previous[0]
 		for i := 0; i <= level; i++ {
 			node.forward[i] = previous[i].forward[i]
 			previous[i].forward[i] = node
@@ -410,7 +568,9 @@ func Merge[K, V any](sl1, sl2 *SkipList[K, V]) *SkipList[K, V] {
 
 	for p1 != nil {
 		level := randomLevel(newMaxLevel)
+		newLevel = max(newLevel, level)
 		node := newNode[K, V](level, p1.key, p1.val)
+		node.backward = previous[0]
 		for i := 0; i <= level; i++ {
 			node.forward[i] = previous[i].forward[i]
 			previous[i].forward[i] = node
@@ -422,7 +582,9 @@ func Merge[K, V any](sl1, sl2 *SkipList[K, V]) *SkipList[K, V] {
 
 	for p2 != nil {
 		level := randomLevel(newMaxLevel)
+		newLevel = max(newLevel, level)
 		node := newNode[K, V](level, p2.key, p2.val)
+		node.backward = previous[0]
 		for i := 0; i <= level; i++ {
 			node.forward[i] = previous[i].forward[i]
 			previous[i].forward[i] = node
@@ -435,11 +597,17 @@ func Merge[K, V any](sl1, sl2 *SkipList[K, V]) *SkipList[K, V] {
 	sl1.rw.Unlock()
 	sl2.rw.Unlock()
 
+	newMax := previous[0]
+	if newMax.isHeader {
+		newMax = nil
+	}
+
 	return &SkipList[K, V]{
 		maxLevel: newMaxLevel,
 		level:    newLevel,
 		size:     newSize,
-		lessThan: sl1.lessThan,
+		compare:  sl1.compare,
+		less:     sl1.less,
 		header:   newHead,
 		max:      newMax,
 	}
@@ -461,7 +629,7 @@ func (sl *SkipList[K, V]) searchNode(searchKey K) ([]*slNode[K, V], *slNode[K, V
 	previous := make([]*slNode[K, V], sl.maxLevel)
 	x := sl.header
 	for i := sl.level; i >= 0; i-- {
-		for x.forward[i] != nil && sl.lessThan(x.forward[i].key, searchKey) {
+		for x.forward[i] != nil && sl.less(x.forward[i].key, searchKey) {
 			x = x.forward[i]
 		}
 		previous[i] = x
@@ -469,82 +637,204 @@ func (sl *SkipList[K, V]) searchNode(searchKey K) ([]*slNode[K, V], *slNode[K, V
 	return previous, x
 }
 
-// Inserts a key-value pair but doesn't use locks; this is used for the InsertAll() method
-// to acquire a single lock for the bulk insertion
-func (sl *SkipList[K, V]) set(key K, val V) {
-	update, x := sl.searchNode(key)
-	x = x.forward[0]
-	if x != nil && !sl.lessThan(key, x.key) {
-		x.val = val
-	} else {
-		lvl := sl.randomLevel()
-		if lvl > sl.level {
-			for i := sl.level + 1; i <= lvl; i++ {
-				update[i] = sl.header
-			}
-			sl.level = lvl
+// searchBefore returns the last node with key less than searchKey, or the header if there is none.
+// It is searchNode without recording the path, so it doesn't allocate.
+func (sl *SkipList[K, V]) searchBefore(searchKey K) *slNode[K, V] {
+	x := sl.header
+	for i := sl.level; i >= 0; i-- {
+		for x.forward[i] != nil && sl.less(x.forward[i].key, searchKey) {
+			x = x.forward[i]
 		}
+	}
+	return x
+}
 
-		x = newNode[K](lvl, key, val)
-		for i := 0; i <= lvl; i++ {
-			x.forward[i] = update[i].forward[i]
-			update[i].forward[i] = x
+// searchFrom is searchNode for keys in ascending order: each level's search resumes from the node
+// that the previous search left in update, rather than from the header, and update is overwritten
+// with the result. Every entry of update must be the header before the first search.
+func (sl *SkipList[K, V]) searchFrom(update []*slNode[K, V], searchKey K) *slNode[K, V] {
+	x := sl.header
+	for i := sl.level; i >= 0; i-- {
+		if x.isHeader || (!update[i].isHeader && sl.less(x.key, update[i].key)) {
+			x = update[i]
 		}
-		x.backward = update[0]
-		if x.forward[0] != nil {
-			x.forward[0].backward = x
+		for x.forward[i] != nil && sl.less(x.forward[i].key, searchKey) {
+			x = x.forward[i]
 		}
-		if sl.max == nil || sl.lessThan(sl.max.key, x.key) {
-			sl.max = x
+		update[i] = x
+	}
+	return x
+}
+
+// searchFunc returns the last node with a key for which before returns true, or the header if there
+// is none. before must return true for every key up to some point in the list and false after it.
+func (sl *SkipList[K, V]) searchFunc(before func(K) bool) *slNode[K, V] {
+	x := sl.header
+	for i := sl.level; i >= 0; i-- {
+		for x.forward[i] != nil && before(x.forward[i].key) {
+			x = x.forward[i]
 		}
+	}
+	return x
+}
 
-		sl.size++
+// predecessors returns an array containing the node that links to x on each level x is on. It
+// follows backward pointers instead of searching from the header, so it makes no key comparisons.
+// If the list has a monoid, the links above x also need fixing, so it searches for x instead.
+func (sl *SkipList[K, V]) predecessors(x *slNode[K, V]) []*slNode[K, V] {
+	if sl.monoid != nil {
+		previous, _ := sl.searchNode(x.key)
+		return previous
+	}
+	previous := make([]*slNode[K, V], sl.maxLevel)
+	p := x.backward
+	for i := 0; i <= x.level(); i++ {
+		for p.level() < i {
+			p = p.backward
+		}
+		previous[i] = p
 	}
+	return previous
 }
 
-// Deletes a key-value pair but doesn't use locks; this is used for the DeleteAll()
-// method to acquire a single lock for the bulk deletion
-func (sl *SkipList[K, V]) delete(key K) {
+// set inserts a key-value pair but doesn't use locks; this is used by Set and by the bulk
+// methods, like SetAll(), that acquire a single lock for the whole operation.
+func (sl *SkipList[K, V]) set(key K, val V) (bool, V) {
 	update, x := sl.searchNode(key)
 	x = x.forward[0]
-	if x != nil && !sl.lessThan(key, x.key) {
-		if x.forward[0] == nil {
-			sl.max = update[0]
-		}
-		if sl.max.isHeader {
-			sl.max = nil
-		}
-		for i := 0; i <= sl.level; i++ {
-			if update[i].forward[i] != x {
-				break
-			}
-			update[i].forward[i] = x.forward[i]
-		}
-		if x.forward[0] != nil {
-			x.forward[0].backward = update[0]
+	if x != nil && !sl.less(key, x.key) {
+		return false, sl.updateNode(update, x, val)
+	}
+	sl.insertNode(update, key, val)
+	var oldVal V
+	return true, oldVal
+}
+
+// delete removes a key-value pair but doesn't use locks; this is used by Delete and by the bulk
+// methods, like DeleteAll(), that acquire a single lock for the whole operation.
+func (sl *SkipList[K, V]) delete(key K) (V, bool) {
+	update, x := sl.searchNode(key)
+	x = x.forward[0]
+	if x != nil && !sl.less(key, x.key) {
+		sl.removeNode(update, x)
+		return x.val, true
+	}
+	var val V
+	return val, false
+}
+
+// insertNode links a new node holding the key-value pair in after the nodes in update, which
+// must be the result of searching for key. Every insertion into the list goes through here.
+func (sl *SkipList[K, V]) insertNode(update []*slNode[K, V], key K, val V) *slNode[K, V] {
+	lvl := sl.randomLevel()
+	if lvl > sl.level {
+		for i := sl.level + 1; i <= lvl; i++ {
+			update[i] = sl.header
 		}
-		x = nil
-		sl.size--
-		for i := sl.level; i > 0 && sl.header.forward[sl.level] == nil; i-- {
-			sl.level -= 1
+		sl.level = lvl
+	}
+
+	x := newNode[K](lvl, key, val)
+	for i := 0; i <= lvl; i++ {
+		x.forward[i] = update[i].forward[i]
+		update[i].forward[i] = x
+	}
+	x.backward = update[0]
+	if x.forward[0] != nil {
+		x.forward[0].backward = x
+	}
+	if sl.max == nil || sl.less(sl.max.key, x.key) {
+		sl.max = x
+	}
+
+	sl.size++
+	sl.modCount++
+	if sl.monoid != nil {
+		sl.fixAggregates(update, x)
+	}
+	sl.logWrite(key)
+	sl.publish(Event[K, V]{Type: EventInsert, Key: key, Value: val})
+	return x
+}
+
+// updateNode replaces the value of an existing node and returns the old value. update is the
+// result of searching for x's key, or nil if no search was done. Every update of a value in the
+// list goes through here.
+func (sl *SkipList[K, V]) updateNode(update []*slNode[K, V], x *slNode[K, V], val V) V {
+	oldVal := x.val
+	x.val = val
+	if sl.monoid != nil {
+		if update == nil {
+			update, _ = sl.searchNode(x.key)
 		}
+		sl.fixAggregates(update, x)
 	}
+	sl.logWrite(x.key)
+	sl.publish(Event[K, V]{Type: EventUpdate, Key: x.key, Value: val, OldValue: oldVal})
+	return oldVal
 }
 
-// iterator returns an Iterator beginning at the given node and ending at node with the given endKey (exclusive).
-// If endKey is nil, the iterator goes until the end of the list. If start is nil, this would suggest the list
-// is empty, so it returns nil.
-func (sl *SkipList[K, V]) iterator(start *slNode[K, V], endKey *K) Iterator[K, V] {
+// removeNode unlinks x from the list, where update must be the result of searching for x's key.
+// Every deletion from the list goes through here.
+func (sl *SkipList[K, V]) removeNode(update []*slNode[K, V], x *slNode[K, V]) {
+	if x.forward[0] == nil {
+		sl.max = update[0]
+	}
+	if sl.max.isHeader {
+		sl.max = nil
+	}
+	for i := 0; i <= x.level(); i++ {
+		update[i].forward[i] = x.forward[i]
+	}
+	if x.forward[0] != nil {
+		x.forward[0].backward = update[0]
+	}
+	sl.size--
+	sl.modCount++
+	for i := sl.level; i > 0 && sl.header.forward[sl.level] == nil; i-- {
+		sl.level -= 1
+	}
+	if sl.monoid != nil {
+		sl.fixAggregates(update, nil)
+	}
+	sl.logWrite(x.key)
+	sl.publish(Event[K, V]{Type: EventDelete, Key: x.key, Value: x.val})
+}
+
+// iterWithin returns an iterator in the gap before the first key within the bounds. It must be
+// called with the read lock held.
+func (sl *SkipList[K, V]) iterWithin(bounds Bounds[K]) *iter[K, V] {
+	it := sl.iterator(sl.header, bounds.Lower, bounds.Upper).(*iter[K, V])
+	it.curr = it.first()
+	return it
+}
+
+// iterWhere returns an iterator over the run of consecutive keys for which where returns 0, where
+// where returns a negative number for every key before the run and a positive number for every
+// key after it. It is in the gap before the run, like Iter's iterators.
+func (sl *SkipList[K, V]) iterWhere(where func(K) int) Iterator[K, V] {
 	sl.rw.RLock()
 	defer sl.rw.RUnlock()
 
+	it := sl.iterator(sl.header, Unbounded[K](), Unbounded[K]()).(*iter[K, V])
+	it.where = where
+	it.curr = it.first()
+	return it
+}
+
+// iterator returns an Iterator in the gap after the given node that visits only keys between the
+// bounds lo and hi. If start is nil, this would suggest the list is empty, so it returns nil. It
+// must be called with the read lock held.
+func (sl *SkipList[K, V]) iterator(start *slNode[K, V], lo, hi Bound[K]) Iterator[K, V] {
 	if start == nil {
 		return nil
 	}
 
 	return &iter[K, V]{
-		lessThan:    sl.lessThan,
-		curr:        start,
-		rangeEndKey: endKey,
+		sl:       sl,
+		curr:     start,
+		lo:       lo,
+		hi:       hi,
+		modCount: sl.modCount,
 	}
 }
@@ -3,8 +3,13 @@ package skiplist
 import (
 	"bytes"
 	"fmt"
+	"math/rand"
+	"net/netip"
 	"slices"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestSkipList_Set(t *testing.T) {
@@ -48,7 +53,7 @@ func TestSkipList_DeleteAll(t *testing.T) {
 
 	sl.SetAll(items)
 
-	sl.deleteAll(2, -5, 7, -1, 10)
+	sl.DeleteAll(2, -5, 7, -1, 10)
 	fmt.Println(sl)
 	it := sl.IteratorFromEnd()
 	for it.Prev() {
@@ -314,3 +319,218 @@ func TestSkipList_Concurrent(t *testing.T) {
 	sl := NewSkipList[int, string]()
 	fmt.Println(sl)
 }
+
+func TestSkipList_SetIfAbsent(t *testing.T) {
+	sl := NewSkipList[int, string]()
+
+	actual, loaded := sl.SetIfAbsent(1, "one")
+	if loaded || actual != "one" {
+		t.Errorf("set if absent on missing key: got %v, %v", actual, loaded)
+	}
+	actual, loaded = sl.SetIfAbsent(1, "uno")
+	if !loaded || actual != "one" {
+		t.Errorf("set if absent on existing key: got %v, %v", actual, loaded)
+	}
+
+	previous, loaded := sl.Swap(1, "uno")
+	if !loaded || previous != "one" {
+		t.Errorf("swap on existing key: got %v, %v", previous, loaded)
+	}
+	previous, loaded = sl.Swap(2, "two")
+	if loaded || previous != "" {
+		t.Errorf("swap on missing key: got %v, %v", previous, loaded)
+	}
+	if sl.Len() != 2 {
+		t.Errorf("swap: want 2 elements, got %d", sl.Len())
+	}
+}
+
+func TestSkipList_CompareAndSwap(t *testing.T) {
+	sl := NewSkipList(Pair[int, string]{1, "one"})
+
+	if sl.CompareAndSwap(1, "two", "three") {
+		t.Error("compare and swap succeeded with the wrong old value")
+	}
+	if !sl.CompareAndSwap(1, "one", "uno") {
+		t.Error("compare and swap failed with the right old value")
+	}
+	if sl.CompareAndSwap(2, "", "two") {
+		t.Error("compare and swap succeeded on a missing key")
+	}
+	if val, _ := sl.Get(1); val != "uno" {
+		t.Errorf("compare and swap: want %v, got %v", "uno", val)
+	}
+
+	if sl.CompareAndDelete(1, "one") {
+		t.Error("compare and delete succeeded with the wrong old value")
+	}
+	if !sl.CompareAndDelete(1, "uno") || !sl.IsEmpty() {
+		t.Error("compare and delete failed with the right old value")
+	}
+}
+
+func TestSkipList_SetIfAbsentConcurrent(t *testing.T) {
+	sl := NewSkipList[int, int]()
+
+	var wg sync.WaitGroup
+	var inserted atomic.Int32
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for key := 0; key < 100; key++ {
+				if _, loaded := sl.SetIfAbsent(key, i); !loaded {
+					inserted.Add(1)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if inserted.Load() != 100 || sl.Len() != 100 {
+		t.Errorf("concurrent set if absent: want 100 inserts, got %d and %d elements", inserted.Load(), sl.Len())
+	}
+}
+
+func TestSkipList_Compute(t *testing.T) {
+	sl := NewSkipList[string, int]()
+
+	incr := func(old int, exists bool) (int, Op) {
+		return old + 1, OpSet
+	}
+	for i := 0; i < 3; i++ {
+		sl.Compute("counter", incr)
+	}
+	if val, ok := sl.Get("counter"); !ok || val != 3 {
+		t.Errorf("compute increment: want 3, got %v, %v", val, ok)
+	}
+
+	val, ok := sl.Compute("counter", func(old int, exists bool) (int, Op) {
+		return 0, OpKeep
+	})
+	if !ok || val != 3 {
+		t.Errorf("compute keep: want 3, got %v, %v", val, ok)
+	}
+
+	val, ok = sl.Compute("counter", func(old int, exists bool) (int, Op) {
+		return 0, OpDelete
+	})
+	if ok || val != 0 || !sl.IsEmpty() {
+		t.Errorf("compute delete: got %v, %v with %d elements", val, ok, sl.Len())
+	}
+
+	val, ok = sl.Compute("missing", func(old int, exists bool) (int, Op) {
+		if exists {
+			t.Error("compute: missing key reported as existing")
+		}
+		return 0, OpDelete
+	})
+	if ok || val != 0 {
+		t.Errorf("compute delete missing: got %v, %v", val, ok)
+	}
+}
+
+func TestNewCustomSkipList_Calls(t *testing.T) {
+	calls := 0
+	sl := NewCustomSkipList[int, int](func(k1, k2 int) bool {
+		calls++
+		return k1 < k2
+	})
+	for i := 0; i < 1000; i++ {
+		sl.Set(rand.Intn(10000), i)
+	}
+	sl.Set(5000, 0)
+
+	want := sl.searchPathLen(5000) + 1
+	calls = 0
+	if _, ok := sl.Get(5000); !ok {
+		t.Error("get: key 5000 not found")
+	}
+	if calls != want {
+		t.Errorf("get: want %d comparisons, got %d", want, calls)
+	}
+}
+
+func TestNewSkipListFunc(t *testing.T) {
+	calls := 0
+	sl := NewSkipListFunc[[]byte, string](func(bs1, bs2 []byte) int {
+		calls++
+		return bytes.Compare(bs1, bs2)
+	})
+	for _, s := range []string{"foo", "bar", "baz", "qux", "quux"} {
+		sl.Set([]byte(s), s)
+	}
+
+	want := sl.searchPathLen([]byte("baz")) + 1
+	calls = 0
+	val, ok := sl.Get([]byte("baz"))
+	if !ok || val != "baz" {
+		t.Errorf("get: want baz, got %v, %v", val, ok)
+	}
+	if calls != want {
+		t.Errorf("get: want %d comparisons, got %d", want, calls)
+	}
+
+	if _, ok := sl.Delete([]byte("bar")); !ok {
+		t.Error("delete: key bar not found")
+	}
+	if err := sl.Validate(); err != nil {
+		t.Errorf("validate: %v", err)
+	}
+	if first := sl.First(); string(first.Key()) != "baz" {
+		t.Errorf("first: want baz, got %s", first.Key())
+	}
+}
+
+type version struct {
+	major, minor int
+}
+
+func (v version) Less(other version) bool {
+	return v.major < other.major || (v.major == other.major && v.minor < other.minor)
+}
+
+func TestNewComparerSkipList(t *testing.T) {
+	now := time.Now()
+	times := NewComparerSkipList[time.Time, string]()
+	times.Set(now.Add(time.Hour), "later")
+	times.Set(now, "now")
+	times.Set(now.Add(-time.Hour), "earlier")
+
+	if val, ok := times.Get(now.Round(0)); !ok || val != "now" {
+		t.Errorf("time keys: want now, got %v, %v", val, ok)
+	}
+	if first := times.First(); first.Value() != "earlier" {
+		t.Errorf("time keys: want earlier first, got %v", first)
+	}
+
+	addrs := NewComparerSkipList(
+		Pair[netip.Addr, string]{netip.MustParseAddr("10.0.0.2"), "b"},
+		Pair[netip.Addr, string]{netip.MustParseAddr("10.0.0.10"), "c"},
+		Pair[netip.Addr, string]{netip.MustParseAddr("10.0.0.1"), "a"},
+	)
+	var got []string
+	for it := addrs.Iterator(); it.Next(); {
+		got = append(got, it.Value())
+	}
+	if want := []string{"a", "b", "c"}; !slices.Equal(got, want) {
+		t.Errorf("addr keys: want %v, got %v", want, got)
+	}
+}
+
+func TestNewLesserSkipList(t *testing.T) {
+	sl := NewLesserSkipList[version, string]()
+	sl.Set(version{1, 10}, "1.10")
+	sl.Set(version{1, 2}, "1.2")
+	sl.Set(version{0, 9}, "0.9")
+
+	if last := sl.Last(); last.Value() != "1.10" {
+		t.Errorf("lesser keys: want 1.10 last, got %v", last)
+	}
+	if val, ok := sl.Get(version{1, 2}); !ok || val != "1.2" {
+		t.Errorf("lesser keys: want 1.2, got %v, %v", val, ok)
+	}
+	if err := Merge(sl, NewLesserSkipList(Pair[version, string]{version{1, 5}, "1.5"})).Validate(); err != nil {
+		t.Errorf("lesser keys merge: %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package skiplist
+
+import (
+	"fmt"
+	"strings"
+	"unsafe"
+)
+
+// statsSampleSize is the maximum number of keys Stats searches for to measure search paths.
+const statsSampleSize = 1024
+
+// Stats describes the shape of a skip list, which helps with choosing a max level.
+type Stats struct {
+	Len           int     // the number of elements
+	Level         int     // the number of levels in use
+	MaxLevel      int     // the maximum number of levels any node can be on
+	NodesPerLevel []int   // the number of nodes on each level in use, from the bottom up
+	AvgHeight     float64 // the average number of levels a node is on
+	ForwardBytes  int     // the approximate memory used by the forward pointers of all nodes and the header
+	SampledKeys   int     // the number of keys searched for to measure search paths
+	AvgSearchPath float64 // the average number of key comparisons a search for a sampled key made
+	MaxSearchPath int     // the largest number of key comparisons a search for a sampled key made
+}
+
+// String returns a multi-line summary of the statistics.
+func (s Stats) String() string {
+	bldr := strings.Builder{}
+	fmt.Fprintf(&bldr, "len: %d, level: %d/%d, avg height: %.2f, forward bytes: %d\n",
+		s.Len, s.Level, s.MaxLevel, s.AvgHeight, s.ForwardBytes)
+	fmt.Fprintf(&bldr, "search path over %d keys: avg %.2f, max %d",
+		s.SampledKeys, s.AvgSearchPath, s.MaxSearchPath)
+	for level := len(s.NodesPerLevel) - 1; level >= 0; level-- {
+		fmt.Fprintf(&bldr, "\nlevel %2d: %d", level, s.NodesPerLevel[level])
+	}
+	return bldr.String()
+}
+
+// Stats returns statistics about the structure of the skip list. The search path statistics are
+// measured over up to 1024 keys sampled evenly across the list.
+// Time complexity: O(N), where N is the number of elements in the skip list.
+func (sl *SkipList[K, V]) Stats() Stats {
+	sl.rw.RLock()
+	defer sl.rw.RUnlock()
+
+	stats := Stats{
+		Len:           sl.size,
+		Level:         sl.level + 1,
+		MaxLevel:      sl.maxLevel + 1,
+		NodesPerLevel: make([]int, sl.level+1),
+	}
+
+	ptrSize := int(unsafe.Sizeof(sl.header))
+	sliceSize := int(unsafe.Sizeof(sl.header.forward))
+	stats.ForwardBytes = sliceSize + cap(sl.header.forward)*ptrSize
+
+	step := sl.size/statsSampleSize + 1
+	totalHeight, totalPath := 0, 0
+	for i, x := 0, sl.header.forward[0]; x != nil; i, x = i+1, x.forward[0] {
+		for level := 0; level <= x.level(); level++ {
+			stats.NodesPerLevel[level]++
+		}
+		totalHeight += len(x.forward)
+		stats.ForwardBytes += sliceSize + cap(x.forward)*ptrSize
+
+		if i%step == 0 {
+			path := sl.searchPathLen(x.key)
+			totalPath += path
+			stats.MaxSearchPath = max(stats.MaxSearchPath, path)
+			stats.SampledKeys++
+		}
+	}
+	if sl.size > 0 {
+		stats.AvgHeight = float64(totalHeight) / float64(sl.size)
+		stats.AvgSearchPath = float64(totalPath) / float64(stats.SampledKeys)
+	}
+	return stats
+}
+
+// searchPathLen returns the number of key comparisons searchNode makes when searching for key.
+func (sl *SkipList[K, V]) searchPathLen(searchKey K) int {
+	n := 0
+	x := sl.header
+	for i := sl.level; i >= 0; i-- {
+		for x.forward[i] != nil {
+			n++
+			if !sl.less(x.forward[i].key, searchKey) {
+				break
+			}
+			x = x.forward[i]
+		}
+	}
+	return n
+}
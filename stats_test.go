@@ -0,0 +1,48 @@
+package skiplist
+
+import (
+	"testing"
+)
+
+func TestSkipList_Stats(t *testing.T) {
+	sl := NewSkipList[int, int]()
+
+	stats := sl.Stats()
+	if stats.Len != 0 || stats.SampledKeys != 0 || stats.AvgSearchPath != 0 {
+		t.Errorf("stats on empty list: got %+v", stats)
+	}
+
+	for i := 0; i < 5000; i++ {
+		sl.Set(i, i)
+	}
+	stats = sl.Stats()
+
+	if stats.Len != 5000 || stats.NodesPerLevel[0] != 5000 {
+		t.Errorf("stats: want 5000 nodes on level 0, got %d", stats.NodesPerLevel[0])
+	}
+	if len(stats.NodesPerLevel) != stats.Level {
+		t.Errorf("stats: want %d levels in histogram, got %d", stats.Level, len(stats.NodesPerLevel))
+	}
+	total := 0
+	for i, n := range stats.NodesPerLevel {
+		total += n
+		if i > 0 && n > stats.NodesPerLevel[i-1] {
+			t.Errorf("stats: level %d has more nodes than the level below it", i)
+		}
+	}
+	if avg := float64(total) / 5000; avg != stats.AvgHeight {
+		t.Errorf("stats: want average height %v, got %v", avg, stats.AvgHeight)
+	}
+	if stats.SampledKeys != 1000 {
+		t.Errorf("stats: want 1000 sampled keys, got %d", stats.SampledKeys)
+	}
+	if stats.AvgSearchPath <= 0 || float64(stats.MaxSearchPath) < stats.AvgSearchPath {
+		t.Errorf("stats: bad search path lengths, avg %v and max %d", stats.AvgSearchPath, stats.MaxSearchPath)
+	}
+	if stats.ForwardBytes < total*8 {
+		t.Errorf("stats: forward bytes %d less than the pointers alone", stats.ForwardBytes)
+	}
+	if stats.String() == "" {
+		t.Error("stats: empty string")
+	}
+}
@@ -0,0 +1,91 @@
+package skiplist
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// Tuple2 is a composite key of two ordered components, ordered lexicographically: by First, then
+// by Second. It has a Compare method, so a list of them is created with NewComparerSkipList.
+type Tuple2[A, B cmp.Ordered] struct {
+	First  A
+	Second B
+}
+
+// NewTuple2 returns a new two-component key.
+func NewTuple2[A, B cmp.Ordered](a A, b B) Tuple2[A, B] {
+	return Tuple2[A, B]{a, b}
+}
+
+// Compare returns a negative number, zero or a positive number when t is less than, equal to or
+// greater than other.
+func (t Tuple2[A, B]) Compare(other Tuple2[A, B]) int {
+	if c := cmp.Compare(t.First, other.First); c != 0 {
+		return c
+	}
+	return cmp.Compare(t.Second, other.Second)
+}
+
+// String returns a string representation of the key.
+func (t Tuple2[A, B]) String() string {
+	return fmt.Sprintf("(%v, %v)", t.First, t.Second)
+}
+
+// Tuple3 is a composite key of three ordered components, ordered lexicographically: by First,
+// then by Second, then by Third. It has a Compare method, so a list of them is created with
+// NewComparerSkipList.
+type Tuple3[A, B, C cmp.Ordered] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// NewTuple3 returns a new three-component key.
+func NewTuple3[A, B, C cmp.Ordered](a A, b B, c C) Tuple3[A, B, C] {
+	return Tuple3[A, B, C]{a, b, c}
+}
+
+// Compare returns a negative number, zero or a positive number when t is less than, equal to or
+// greater than other.
+func (t Tuple3[A, B, C]) Compare(other Tuple3[A, B, C]) int {
+	if c := cmp.Compare(t.First, other.First); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(t.Second, other.Second); c != 0 {
+		return c
+	}
+	return cmp.Compare(t.Third, other.Third)
+}
+
+// String returns a string representation of the key.
+func (t Tuple3[A, B, C]) String() string {
+	return fmt.Sprintf("(%v, %v, %v)", t.First, t.Second, t.Third)
+}
+
+// Tuple2PrefixIterator returns a bidirectional iterator over the keys whose first component is a.
+// The list must be ordered by Tuple2.Compare. Like Iter, the iterator is never nil, and the first
+// call to Next moves it onto the first matching key.
+func Tuple2PrefixIterator[A, B cmp.Ordered, V any](sl *SkipList[Tuple2[A, B], V], a A) Iterator[Tuple2[A, B], V] {
+	return sl.iterWhere(func(key Tuple2[A, B]) int {
+		return cmp.Compare(key.First, a)
+	})
+}
+
+// Tuple3PrefixIterator returns a bidirectional iterator over the keys whose first component is a.
+// The list must be ordered by Tuple3.Compare.
+func Tuple3PrefixIterator[A, B, C cmp.Ordered, V any](sl *SkipList[Tuple3[A, B, C], V], a A) Iterator[Tuple3[A, B, C], V] {
+	return sl.iterWhere(func(key Tuple3[A, B, C]) int {
+		return cmp.Compare(key.First, a)
+	})
+}
+
+// Tuple3Prefix2Iterator returns a bidirectional iterator over the keys whose first component is a
+// and whose second component is b. The list must be ordered by Tuple3.Compare.
+func Tuple3Prefix2Iterator[A, B, C cmp.Ordered, V any](sl *SkipList[Tuple3[A, B, C], V], a A, b B) Iterator[Tuple3[A, B, C], V] {
+	return sl.iterWhere(func(key Tuple3[A, B, C]) int {
+		if c := cmp.Compare(key.First, a); c != 0 {
+			return c
+		}
+		return cmp.Compare(key.Second, b)
+	})
+}
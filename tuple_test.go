@@ -0,0 +1,79 @@
+package skiplist
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTuple2_Compare(t *testing.T) {
+	tests := []struct {
+		t1, t2 Tuple2[string, int]
+		want   int
+	}{
+		{NewTuple2("a", 2), NewTuple2("b", 1), -1},
+		{NewTuple2("b", 1), NewTuple2("a", 2), 1},
+		{NewTuple2("a", 1), NewTuple2("a", 2), -1},
+		{NewTuple2("a", 2), NewTuple2("a", 2), 0},
+	}
+	for _, test := range tests {
+		if got := test.t1.Compare(test.t2); got != test.want {
+			t.Errorf("compare %v and %v: want %d, got %d", test.t1, test.t2, test.want, got)
+		}
+	}
+}
+
+func TestTuple2PrefixIterator(t *testing.T) {
+	sl := NewComparerSkipList[Tuple2[string, int], string]()
+	for _, tenant := range []string{"acme", "globex", "initech"} {
+		for _, ts := range []int{-5, 0, 3} {
+			sl.Set(NewTuple2(tenant, ts), tenant)
+		}
+	}
+
+	var got []int
+	it := Tuple2PrefixIterator(sl, "globex")
+	for it.Next() {
+		if it.Value() != "globex" {
+			t.Errorf("tuple prefix: got key %v", it.Key())
+		}
+		got = append(got, it.Key().Second)
+	}
+	if want := []int{-5, 0, 3}; !slices.Equal(got, want) {
+		t.Errorf("tuple prefix: want %v, got %v", want, got)
+	}
+	if !it.SeekToLast() || it.Key() != NewTuple2("globex", 3) {
+		t.Errorf("tuple prefix seek to last: got %v", it.Key())
+	}
+	if Tuple2PrefixIterator(sl, "hooli").Next() {
+		t.Error("tuple prefix: found keys for a missing prefix")
+	}
+}
+
+func TestTuple3PrefixIterator(t *testing.T) {
+	sl := NewComparerSkipList[Tuple3[string, int64, string], int]()
+	i := 0
+	for _, tenant := range []string{"a", "b"} {
+		for _, ts := range []int64{1, 2} {
+			for _, id := range []string{"x", "y"} {
+				sl.Set(NewTuple3(tenant, ts, id), i)
+				i++
+			}
+		}
+	}
+
+	var got []int
+	for it := Tuple3PrefixIterator(sl, "b"); it.Next(); {
+		got = append(got, it.Value())
+	}
+	if want := []int{4, 5, 6, 7}; !slices.Equal(got, want) {
+		t.Errorf("tuple3 prefix: want %v, got %v", want, got)
+	}
+
+	got = nil
+	for it := Tuple3Prefix2Iterator(sl, "a", 2); it.Next(); {
+		got = append(got, it.Value())
+	}
+	if want := []int{2, 3}; !slices.Equal(got, want) {
+		t.Errorf("tuple3 prefix2: want %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,228 @@
+package skiplist
+
+import (
+	"errors"
+	"slices"
+)
+
+// ErrConflict is returned by Update and View when a write committed while the transaction was
+// running touched a key or range the transaction read, so its reads may be stale.
+var ErrConflict = errors.New("skiplist: transaction conflict")
+
+// ErrReadOnly is returned by Set and Delete on a transaction started by View.
+var ErrReadOnly = errors.New("skiplist: write in read-only transaction")
+
+// Txn is an optimistic transaction over a skip list. Writes are buffered in the transaction and
+// only applied to the list when it commits, and reads see the transaction's own writes. The keys
+// and ranges it reads are recorded, and at commit time the transaction fails with ErrConflict if
+// any write committed since it began touched them, which makes transactions serializable without
+// holding a lock while user code runs. A Txn must not be used after its Update or View returns,
+// nor from more than one goroutine.
+type Txn[K, V any] struct {
+	sl       *SkipList[K, V]
+	readOnly bool
+	start    int             // the position in the list's write log when the transaction began
+	writes   []batchOp[K, V] // the buffered writes, sorted by key
+	reads    []K             // the keys read
+	ranges   []Bounds[K]     // the ranges read
+}
+
+// txnLogEntry is a write recorded in a skip list's write log for validating transactions. An
+// entry with all set is written by Clear and conflicts with every read.
+type txnLogEntry[K any] struct {
+	key K
+	all bool
+}
+
+// Update runs fn in a read-write transaction and commits its writes atomically if fn returns nil.
+// If fn returns an error the writes are discarded and the error is returned. If a concurrent write
+// touched anything the transaction read, the writes are discarded and ErrConflict is returned, in
+// which case the caller may retry.
+func (sl *SkipList[K, V]) Update(fn func(tx *Txn[K, V]) error) error {
+	tx := sl.begin(false)
+	defer sl.end(tx)
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return sl.commit(tx)
+}
+
+// View runs fn in a read-only transaction. If a concurrent write touched anything the transaction
+// read, so that its reads may not be consistent with each other, ErrConflict is returned.
+func (sl *SkipList[K, V]) View(fn func(tx *Txn[K, V]) error) error {
+	tx := sl.begin(true)
+	defer sl.end(tx)
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return sl.commit(tx)
+}
+
+// Get returns the value associated with the key if the key exists and a bool indicating if it
+// does, taking the transaction's own writes into account.
+func (tx *Txn[K, V]) Get(key K) (V, bool) {
+	if i, ok := tx.find(key); ok {
+		return tx.writes[i].val, !tx.writes[i].delete
+	}
+	tx.reads = append(tx.reads, key)
+	return tx.sl.Get(key)
+}
+
+// Set buffers setting key to val, or returns ErrReadOnly in a read-only transaction.
+func (tx *Txn[K, V]) Set(key K, val V) error {
+	return tx.write(batchOp[K, V]{key: key, val: val})
+}
+
+// Delete buffers deleting key, or returns ErrReadOnly in a read-only transaction.
+func (tx *Txn[K, V]) Delete(key K) error {
+	return tx.write(batchOp[K, V]{key: key, delete: true})
+}
+
+// Range calls fn for each key within the bounds, in order, until fn returns false, taking the
+// transaction's own writes into account. The whole range is recorded as read, so a concurrent
+// insert into it is a conflict too.
+func (tx *Txn[K, V]) Range(bounds Bounds[K], fn func(key K, val V) bool) {
+	tx.ranges = append(tx.ranges, bounds)
+
+	var pairs []Pair[K, V]
+	tx.sl.rw.RLock()
+	it := tx.sl.iterWithin(bounds)
+	for x := it.curr.forward[0]; it.inRange(x); x = x.forward[0] {
+		pairs = append(pairs, Pair[K, V]{x.key, x.val})
+	}
+	tx.sl.rw.RUnlock()
+
+	compare := tx.sl.compare
+	lo := 0
+	if bounds.Lower.set {
+		var found bool
+		if lo, found = tx.find(bounds.Lower.key); found && !bounds.Lower.inclusive {
+			lo++
+		}
+	}
+	hi := lo
+	for hi < len(tx.writes) && bounds.Upper.admitsFromAbove(tx.writes[hi].key, compare) {
+		hi++
+	}
+	writes := tx.writes[lo:hi]
+
+	for len(pairs) > 0 || len(writes) > 0 {
+		var key K
+		var val V
+		if len(writes) == 0 || (len(pairs) > 0 && compare(pairs[0].key, writes[0].key) < 0) {
+			key, val = pairs[0].key, pairs[0].val
+			pairs = pairs[1:]
+		} else {
+			w := writes[0]
+			writes = writes[1:]
+			if len(pairs) > 0 && compare(w.key, pairs[0].key) == 0 {
+				pairs = pairs[1:]
+			}
+			if w.delete {
+				continue
+			}
+			key, val = w.key, w.val
+		}
+		if !fn(key, val) {
+			return
+		}
+	}
+}
+
+func (tx *Txn[K, V]) write(w batchOp[K, V]) error {
+	if tx.readOnly {
+		return ErrReadOnly
+	}
+	if i, ok := tx.find(w.key); ok {
+		tx.writes[i] = w
+	} else {
+		tx.writes = slices.Insert(tx.writes, i, w)
+	}
+	return nil
+}
+
+// find returns the position of key in the buffered writes, or where it would be inserted, and
+// whether it was found.
+func (tx *Txn[K, V]) find(key K) (int, bool) {
+	return slices.BinarySearchFunc(tx.writes, key, func(w batchOp[K, V], key K) int {
+		return tx.sl.compare(w.key, key)
+	})
+}
+
+// begin starts a transaction and, while it's open, makes the list log the keys it writes.
+func (sl *SkipList[K, V]) begin(readOnly bool) *Txn[K, V] {
+	sl.rw.Lock()
+	defer sl.rw.Unlock()
+
+	start := sl.logBase + len(sl.writeLog)
+	sl.txns = append(sl.txns, start)
+	return &Txn[K, V]{
+		sl:       sl,
+		readOnly: readOnly,
+		start:    start,
+	}
+}
+
+// end closes a transaction and trims the write log up to where the oldest transaction still open
+// began, since no transaction needs the entries before that.
+func (sl *SkipList[K, V]) end(tx *Txn[K, V]) {
+	sl.rw.Lock()
+	defer sl.rw.Unlock()
+
+	i, _ := slices.BinarySearch(sl.txns, tx.start)
+	sl.txns = slices.Delete(sl.txns, i, i+1)
+	if len(sl.txns) == 0 {
+		sl.logBase += len(sl.writeLog)
+		sl.writeLog = nil
+		sl.txns = nil
+		return
+	}
+	// once the log outgrows its capacity, append copies only the entries left after the trim
+	n := sl.txns[0] - sl.logBase
+	sl.writeLog = sl.writeLog[n:]
+	sl.logBase += n
+}
+
+// commit validates the transaction's reads against the writes logged since it began and, if none
+// of them conflict, applies its buffered writes under the same lock.
+func (sl *SkipList[K, V]) commit(tx *Txn[K, V]) error {
+	sl.rw.Lock()
+	defer sl.rw.Unlock()
+
+	for _, w := range sl.writeLog[tx.start-sl.logBase:] {
+		if tx.conflicts(w) {
+			return ErrConflict
+		}
+	}
+	sl.apply(tx.writes)
+	return nil
+}
+
+// conflicts returns true if the logged write touched anything the transaction read.
+func (tx *Txn[K, V]) conflicts(w txnLogEntry[K]) bool {
+	if w.all {
+		return len(tx.reads) > 0 || len(tx.ranges) > 0
+	}
+	compare := tx.sl.compare
+	for _, key := range tx.reads {
+		if compare(key, w.key) == 0 {
+			return true
+		}
+	}
+	for _, bounds := range tx.ranges {
+		if bounds.contains(w.key, compare) {
+			return true
+		}
+	}
+	return false
+}
+
+// logWrite records a written key for validating open transactions. It must be called with the
+// write lock held.
+func (sl *SkipList[K, V]) logWrite(key K) {
+	if len(sl.txns) > 0 {
+		sl.writeLog = append(sl.writeLog, txnLogEntry[K]{key: key})
+	}
+}
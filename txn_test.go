@@ -0,0 +1,206 @@
+package skiplist
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestSkipList_Update(t *testing.T) {
+	sl := NewSkipList(Pair[string, int]{"alice", 100}, Pair[string, int]{"bob", 50})
+
+	err := sl.Update(func(tx *Txn[string, int]) error {
+		alice, _ := tx.Get("alice")
+		bob, _ := tx.Get("bob")
+		tx.Set("alice", alice-30)
+		tx.Set("bob", bob+30)
+		if val, _ := tx.Get("alice"); val != 70 {
+			t.Errorf("update: transaction doesn't see its own write, got %d", val)
+		}
+		if val, _ := sl.Get("alice"); val != 100 {
+			t.Errorf("update: write visible before commit, got %d", val)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("update: unexpected error %v", err)
+	}
+	if alice, _ := sl.Get("alice"); alice != 70 {
+		t.Errorf("update: want alice 70, got %d", alice)
+	}
+	if bob, _ := sl.Get("bob"); bob != 80 {
+		t.Errorf("update: want bob 80, got %d", bob)
+	}
+
+	errAbort := errors.New("abort")
+	err = sl.Update(func(tx *Txn[string, int]) error {
+		tx.Delete("alice")
+		return errAbort
+	})
+	if err != errAbort || sl.Len() != 2 {
+		t.Errorf("aborted update: got err %v and %d elements", err, sl.Len())
+	}
+	if len(sl.txns) != 0 || sl.writeLog != nil {
+		t.Errorf("update: transaction state not cleaned up, %d open", len(sl.txns))
+	}
+}
+
+func TestSkipList_UpdateConflict(t *testing.T) {
+	sl := NewSkipList(Pair[int, int]{1, 1}, Pair[int, int]{5, 5})
+
+	err := sl.Update(func(tx *Txn[int, int]) error {
+		tx.Get(1)
+		sl.Set(2, 2)
+		tx.Set(3, 3)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("update with an unrelated concurrent write: unexpected error %v", err)
+	}
+
+	err = sl.Update(func(tx *Txn[int, int]) error {
+		tx.Get(1)
+		sl.Set(1, 10)
+		tx.Set(3, 30)
+		return nil
+	})
+	if err != ErrConflict {
+		t.Errorf("update with a conflicting write: want %v, got %v", ErrConflict, err)
+	}
+	if val, _ := sl.Get(3); val != 3 {
+		t.Errorf("conflicting update was applied, got %d", val)
+	}
+
+	err = sl.Update(func(tx *Txn[int, int]) error {
+		tx.Range(Bounds[int]{Inclusive(4), Exclusive(10)}, func(int, int) bool { return true })
+		sl.Set(7, 7)
+		return tx.Set(4, 4)
+	})
+	if err != ErrConflict {
+		t.Errorf("update with an insert into a read range: want %v, got %v", ErrConflict, err)
+	}
+
+	err = sl.View(func(tx *Txn[int, int]) error {
+		tx.Get(5)
+		sl.Clear()
+		return nil
+	})
+	if err != ErrConflict {
+		t.Errorf("view with a concurrent clear: want %v, got %v", ErrConflict, err)
+	}
+}
+
+func TestSkipList_View(t *testing.T) {
+	sl := NewSkipList(Pair[int, int]{1, 1})
+
+	err := sl.View(func(tx *Txn[int, int]) error {
+		return tx.Set(2, 2)
+	})
+	if err != ErrReadOnly {
+		t.Errorf("view: want %v, got %v", ErrReadOnly, err)
+	}
+}
+
+func TestTxn_Range(t *testing.T) {
+	sl := NewSkipList[int, string]()
+	for i := 0; i < 10; i += 2 {
+		sl.Set(i, "list")
+	}
+
+	sl.Update(func(tx *Txn[int, string]) error {
+		tx.Set(3, "tx")
+		tx.Set(4, "tx")
+		tx.Delete(6)
+		tx.Set(11, "tx")
+
+		var keys []int
+		var vals []string
+		tx.Range(Bounds[int]{Exclusive(2), Inclusive(8)}, func(key int, val string) bool {
+			keys = append(keys, key)
+			vals = append(vals, val)
+			return true
+		})
+		if want := []int{3, 4, 8}; !slices.Equal(keys, want) {
+			t.Errorf("txn range: want keys %v, got %v", want, keys)
+		}
+		if want := []string{"tx", "tx", "list"}; !slices.Equal(vals, want) {
+			t.Errorf("txn range: want values %v, got %v", want, vals)
+		}
+		return nil
+	})
+}
+
+func TestTxn_RangeUnbounded(t *testing.T) {
+	one, two := 1, 2
+	sl := NewSkipListFunc[*int, int](func(a, b *int) int { return *a - *b })
+	sl.Set(&two, 2)
+
+	err := sl.Update(func(tx *Txn[*int, int]) error {
+		tx.Set(&one, 1)
+
+		var vals []int
+		tx.Range(Bounds[*int]{}, func(_ *int, val int) bool {
+			vals = append(vals, val)
+			return true
+		})
+		if want := []int{1, 2}; !slices.Equal(vals, want) {
+			t.Errorf("txn range unbounded: want %v, got %v", want, vals)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("txn range unbounded: %v", err)
+	}
+}
+
+func TestSkipList_UpdateConcurrent(t *testing.T) {
+	sl := NewSkipList(Pair[string, int]{"counter", 0})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for n := 0; n < 50; {
+				err := sl.Update(func(tx *Txn[string, int]) error {
+					val, _ := tx.Get("counter")
+					return tx.Set("counter", val+1)
+				})
+				if err == nil {
+					n++
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if val, _ := sl.Get("counter"); val != 400 {
+		t.Errorf("concurrent updates: want 400, got %d", val)
+	}
+}
+
+func TestSkipList_TxnLogTrim(t *testing.T) {
+	sl := NewSkipList[int, int]()
+
+	// overlapping transactions never all close at once, so the log is trimmed as each one ends
+	tx := sl.begin(true)
+	for i := 0; i < 1000; i++ {
+		next := sl.begin(true)
+		sl.Set(i, i)
+		sl.end(tx)
+		tx = next
+	}
+	if len(sl.writeLog) != 1 || len(sl.txns) != 1 {
+		t.Errorf("txn log trim: want 1 entry and 1 open transaction, got %d and %d", len(sl.writeLog), len(sl.txns))
+	}
+
+	sl.Set(-1, -1)
+	if err := sl.commit(tx); err != nil {
+		t.Errorf("txn log trim: want no conflict, got %v", err)
+	}
+	sl.end(tx)
+	if len(sl.txns) != 0 || sl.writeLog != nil {
+		t.Errorf("txn log trim: transaction state not cleaned up, %d open", len(sl.txns))
+	}
+}
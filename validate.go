@@ -0,0 +1,84 @@
+package skiplist
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrCorrupt is wrapped by the errors Validate returns.
+var ErrCorrupt = errors.New("skiplist: corrupt structure")
+
+// Validate checks the invariants of the skip list and returns an error wrapping ErrCorrupt that
+// describes the first violation found, or nil if there is none. It checks that every level is in
+// strictly ascending order under the list's comparator, that every level is a subsequence of the
+// level below it, that the backward pointers mirror the forward pointers on the bottom level, and
+// that the recorded size, level and maximum agree with the nodes. A comparator that isn't a strict
+// ordering, like one passed to NewCustomSkipList that returns true for equal keys, usually shows
+// up as an ordering violation. Time complexity: O(N), where N is the number of elements.
+func (sl *SkipList[K, V]) Validate() error {
+	sl.rw.RLock()
+	defer sl.rw.RUnlock()
+
+	if sl.header == nil || !sl.header.isHeader {
+		return fmt.Errorf("%w: missing header", ErrCorrupt)
+	}
+	if sl.level < 0 || sl.level >= len(sl.header.forward) {
+		return fmt.Errorf("%w: level %d outside of header with %d levels", ErrCorrupt, sl.level, len(sl.header.forward))
+	}
+
+	size, last := 0, sl.header
+	for x := sl.header.forward[0]; x != nil; last, x = x, x.forward[0] {
+		size++
+		if x.isHeader {
+			return fmt.Errorf("%w: header linked in as element %d", ErrCorrupt, size)
+		}
+		if x.backward != last {
+			return fmt.Errorf("%w: backward pointer of %v doesn't point to the node before it", ErrCorrupt, x)
+		}
+		if len(x.forward) == 0 || x.level() > sl.level {
+			return fmt.Errorf("%w: %v is on %d levels, but the list has %d", ErrCorrupt, x, len(x.forward), sl.level+1)
+		}
+		if sl.compare(x.key, x.key) != 0 {
+			return fmt.Errorf("%w: comparator says %v isn't equal to itself", ErrCorrupt, x)
+		}
+	}
+	if size != sl.size {
+		return fmt.Errorf("%w: size is %d, but there are %d nodes", ErrCorrupt, sl.size, size)
+	}
+	if (sl.max == nil) != last.isHeader || (sl.max != nil && sl.max != last) {
+		return fmt.Errorf("%w: max is %v, but the last node is %v", ErrCorrupt, sl.max, last)
+	}
+
+	for i := 0; i < len(sl.header.forward); i++ {
+		if i > sl.level {
+			if sl.header.forward[i] != nil {
+				return fmt.Errorf("%w: level %d is above the list's level %d but not empty", ErrCorrupt, i, sl.level)
+			}
+			continue
+		}
+		if i > 0 && i == sl.level && sl.header.forward[i] == nil {
+			return fmt.Errorf("%w: the list's level is %d but that level is empty", ErrCorrupt, i)
+		}
+
+		// below walks level i-1 in step with level i, to check every node on level i is on it
+		below := sl.header
+		for prev, x := sl.header, sl.header.forward[i]; x != nil; prev, x = x, x.forward[i] {
+			if x.level() < i {
+				return fmt.Errorf("%w: %v is linked on level %d but only has %d levels", ErrCorrupt, x, i, len(x.forward))
+			}
+			if !prev.isHeader && (sl.compare(prev.key, x.key) >= 0 || sl.compare(x.key, prev.key) <= 0) {
+				return fmt.Errorf("%w: %v comes before %v on level %d but isn't less", ErrCorrupt, prev, x, i)
+			}
+			if i == 0 {
+				continue
+			}
+			for below != nil && below != x {
+				below = below.forward[i-1]
+			}
+			if below == nil {
+				return fmt.Errorf("%w: %v is on level %d but not on level %d", ErrCorrupt, x, i, i-1)
+			}
+		}
+	}
+	return nil
+}
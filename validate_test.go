@@ -0,0 +1,74 @@
+package skiplist
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSkipList_Validate(t *testing.T) {
+	sl := NewSkipList[int, string]()
+	if err := sl.Validate(); err != nil {
+		t.Errorf("validate empty list: %v", err)
+	}
+
+	for i := 0; i < 200; i++ {
+		sl.Set((i*37)%101, "")
+	}
+	sl.DeleteAll(5, 17, 99)
+	if err := sl.Validate(); err != nil {
+		t.Errorf("validate: %v", err)
+	}
+
+	first := sl.header.forward[0]
+	first.key, first.forward[0].key = first.forward[0].key, first.key
+	if err := sl.Validate(); !errors.Is(err, ErrCorrupt) {
+		t.Errorf("validate swapped keys: want %v, got %v", ErrCorrupt, err)
+	}
+	first.key, first.forward[0].key = first.forward[0].key, first.key
+
+	sl.size++
+	if err := sl.Validate(); !errors.Is(err, ErrCorrupt) {
+		t.Errorf("validate wrong size: want %v, got %v", ErrCorrupt, err)
+	}
+	sl.size--
+
+	first.forward[0].backward = sl.header
+	if err := sl.Validate(); !errors.Is(err, ErrCorrupt) {
+		t.Errorf("validate wrong backward pointer: want %v, got %v", ErrCorrupt, err)
+	}
+}
+
+func TestSkipList_ValidateComparator(t *testing.T) {
+	sl := NewCustomSkipList[int, string](func(a, b int) bool { return a <= b })
+	sl.Set(1, "one")
+	sl.Set(2, "two")
+	if err := sl.Validate(); !errors.Is(err, ErrCorrupt) {
+		t.Errorf("validate non-strict comparator: want %v, got %v", ErrCorrupt, err)
+	}
+}
+
+func TestSkipList_ValidateMerge(t *testing.T) {
+	sl1 := NewSkipList(Pair[int, string]{1, "one"}, Pair[int, string]{3, "three"})
+	sl2 := NewSkipList(Pair[int, string]{2, "two"}, Pair[int, string]{3, "tres"}, Pair[int, string]{4, "four"})
+
+	res := Merge(sl1, sl2)
+	if err := res.Validate(); err != nil {
+		t.Errorf("validate merge: %v", err)
+	}
+	if res.Len() != 4 || res.Last().Key() != 4 {
+		t.Errorf("merge: want 4 elements ending in 4, got %v", res)
+	}
+
+	it := res.IteratorFromEnd()
+	want := []int{4, 3, 2, 1}
+	for i := 0; it.Prev(); i++ {
+		if it.Key() != want[i] {
+			t.Errorf("merge backwards: want key %d, got %d", want[i], it.Key())
+		}
+	}
+
+	res = Merge(NewSkipList[int, string](), sl2)
+	if err := res.Validate(); err != nil || res.Len() != 3 {
+		t.Errorf("merge with an empty list: got %d elements and %v", res.Len(), err)
+	}
+}
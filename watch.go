@@ -0,0 +1,136 @@
+package skiplist
+
+import (
+	"fmt"
+	"sync"
+)
+
+// EventType is the kind of mutation described by an Event.
+type EventType int
+
+const (
+	EventInsert EventType = iota // a new key was added to the list
+	EventUpdate                  // the value of an existing key was replaced
+	EventDelete                  // a key was removed from the list
+)
+
+// String returns the name of the event type.
+func (t EventType) String() string {
+	switch t {
+	case EventInsert:
+		return "Insert"
+	case EventUpdate:
+		return "Update"
+	case EventDelete:
+		return "Delete"
+	}
+	return fmt.Sprintf("EventType(%d)", int(t))
+}
+
+// Event describes a single mutation of a key in the skip list.
+type Event[K, V any] struct {
+	Type     EventType
+	Key      K
+	Value    V // the new value for inserts and updates, the removed value for deletes
+	OldValue V // the replaced value for updates, the zero value otherwise
+}
+
+// String returns a string representation of the event.
+func (e Event[K, V]) String() string {
+	return fmt.Sprintf("Event{%v, K: %v, V: %v}", e.Type, e.Key, e.Value)
+}
+
+// watcher buffers the events for a single Watch subscription. Events are queued while the list's
+// write lock is held, so every watcher sees them in commit order, and are delivered by a separate
+// goroutine so that a slow receiver never blocks writers.
+type watcher[K, V any] struct {
+	start, end K
+	mu         sync.Mutex
+	queue      []Event[K, V]
+	signal     chan struct{} // has a pending value when the queue is non-empty
+	done       chan struct{} // closed on cancellation
+	out        chan Event[K, V]
+}
+
+// Watch returns a channel that receives an Event for every insert, update and delete of a key
+// greater than or equal to start (inclusive) and less than end (exclusive), in the order the
+// mutations were committed. Events are buffered without bound until received, so mutations never
+// wait on the receiver. The cancel function stops the subscription and closes the channel; it
+// must not be called while holding a lock on the list, e.g. from inside a Compute callback.
+func (sl *SkipList[K, V]) Watch(start, end K) (<-chan Event[K, V], func()) {
+	w := &watcher[K, V]{
+		start:  start,
+		end:    end,
+		signal: make(chan struct{}, 1),
+		done:   make(chan struct{}),
+		out:    make(chan Event[K, V]),
+	}
+
+	sl.rw.Lock()
+	sl.watchers = append(sl.watchers, w)
+	sl.rw.Unlock()
+
+	go w.run()
+
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			sl.rw.Lock()
+			for i, other := range sl.watchers {
+				if other == w {
+					sl.watchers = append(sl.watchers[:i], sl.watchers[i+1:]...)
+					break
+				}
+			}
+			sl.rw.Unlock()
+			close(w.done)
+		})
+	}
+	return w.out, cancel
+}
+
+// publish queues an event for every watcher whose range contains the event's key. It must be
+// called with the write lock held.
+func (sl *SkipList[K, V]) publish(e Event[K, V]) {
+	for _, w := range sl.watchers {
+		if sl.compare(e.Key, w.start) >= 0 && sl.compare(e.Key, w.end) < 0 {
+			w.push(e)
+		}
+	}
+}
+
+func (w *watcher[K, V]) push(e Event[K, V]) {
+	w.mu.Lock()
+	w.queue = append(w.queue, e)
+	w.mu.Unlock()
+
+	select {
+	case w.signal <- struct{}{}:
+	default:
+	}
+}
+
+// run delivers queued events to the out channel until the watcher is cancelled.
+func (w *watcher[K, V]) run() {
+	defer close(w.out)
+	for {
+		select {
+		case <-w.done:
+			return
+		case <-w.signal:
+		}
+
+		w.mu.Lock()
+		events := w.queue
+		w.queue = nil
+		w.mu.Unlock()
+
+		for _, e := range events {
+			select {
+			case w.out <- e:
+			case <-w.done:
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package skiplist
+
+import (
+	"testing"
+)
+
+func TestSkipList_Watch(t *testing.T) {
+	sl := NewSkipList[int, string]()
+
+	events, cancel := sl.Watch(0, 10)
+	defer cancel()
+
+	sl.Set(-1, "outside")
+	sl.Set(5, "five")
+	sl.Set(5, "FIVE")
+	sl.SetAll([]Pair[int, string]{{2, "two"}, {10, "ten"}})
+	sl.Delete(5)
+	sl.DeleteAll(2, 10)
+	sl.Set(7, "seven")
+	sl.Clear()
+
+	want := []Event[int, string]{
+		{Type: EventInsert, Key: 5, Value: "five"},
+		{Type: EventUpdate, Key: 5, Value: "FIVE", OldValue: "five"},
+		{Type: EventInsert, Key: 2, Value: "two"},
+		{Type: EventDelete, Key: 5, Value: "FIVE"},
+		{Type: EventDelete, Key: 2, Value: "two"},
+		{Type: EventInsert, Key: 7, Value: "seven"},
+		{Type: EventDelete, Key: 7, Value: "seven"},
+	}
+	for i, w := range want {
+		got := <-events
+		if got != w {
+			t.Errorf("watch: event %d: want %v, got %v", i, w, got)
+		}
+	}
+}
+
+func TestSkipList_WatchCancel(t *testing.T) {
+	sl := NewSkipList[int, string]()
+
+	events, cancel := sl.Watch(0, 10)
+	sl.Set(1, "one")
+	cancel()
+	cancel()
+
+	for range events {
+	}
+	if len(sl.watchers) != 0 {
+		t.Errorf("watch cancel: want 0 watchers, got %d", len(sl.watchers))
+	}
+	sl.Set(2, "two")
+}